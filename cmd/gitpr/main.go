@@ -7,8 +7,13 @@ import (
     "os"
 
     "github.com/eujoy/gitpr/internal/app/infra/actions"
+    "github.com/eujoy/gitpr/internal/app/infra/automation"
+    "github.com/eujoy/gitpr/internal/app/infra/categories"
+    "github.com/eujoy/gitpr/internal/app/infra/codeowners"
     "github.com/eujoy/gitpr/internal/app/infra/pullrequests"
+    "github.com/eujoy/gitpr/internal/app/infra/releasenotes"
     "github.com/eujoy/gitpr/internal/app/infra/repository"
+    "github.com/eujoy/gitpr/internal/app/infra/teams"
     "github.com/eujoy/gitpr/internal/app/infra/userrepos"
     "github.com/eujoy/gitpr/internal/config"
     "github.com/eujoy/gitpr/internal/infra/command"
@@ -41,18 +46,39 @@ func main() {
         os.Exit(1)
     }
 
-    urSrv := userrepos.NewService(gitRepoFactory.GetClient())
-    repoSrv := repository.NewService(gitRepoFactory.GetClient())
-    prSrv := pullrequests.NewService(gitRepoFactory.GetClient())
+    urSrv := userrepos.NewService(gitRepoFactory.GetClient(), cfg)
+    automationSrv := automation.NewService(cfg)
+    prSrv := pullrequests.NewService(gitRepoFactory.GetClient(), automationSrv)
     wf := actions.NewService(gitRepoFactory.GetClient())
+    teamsSrv := teams.NewService(gitRepoFactory.GetClient(), cfg)
+
+    repoSrv, err := repository.NewService(gitRepoFactory.GetClient(), cfg)
+    if err != nil {
+        fmt.Printf("Error setting up the service : %v\n", err)
+        os.Exit(1)
+    }
+
+    categoriesSrv, err := categories.NewService(cfg)
+    if err != nil {
+        fmt.Printf("Error setting up the service : %v\n", err)
+        os.Exit(1)
+    }
+
+    codeOwnersSrv := codeowners.NewService(gitRepoFactory.GetClient())
+
+    releaseNotesSrv, err := releasenotes.NewService(gitRepoFactory.GetClient(), utils.New(cfg), cfg)
+    if err != nil {
+        fmt.Printf("Error setting up the service : %v\n", err)
+        os.Exit(1)
+    }
 
     switch cfg.Service.Mode {
     case "cli":
-        startUpCliService(app, cfg, urSrv, prSrv, repoSrv, wf)
+        startUpCliService(app, cfg, urSrv, prSrv, repoSrv, wf, teamsSrv, automationSrv, categoriesSrv, codeOwnersSrv, releaseNotesSrv)
     case "http":
         startUpHTTPServer(cfg, urSrv, prSrv)
     default:
-        startUpCliService(app, cfg, urSrv, prSrv, repoSrv, wf)
+        startUpCliService(app, cfg, urSrv, prSrv, repoSrv, wf, teamsSrv, automationSrv, categoriesSrv, codeOwnersSrv, releaseNotesSrv)
     }
 }
 
@@ -70,11 +96,11 @@ func info(app *cli.App, cfg config.Config) {
 }
 
 // startUpCliService runs the service as a cli tool.
-func startUpCliService(app *cli.App, cfg config.Config, urSrv *userrepos.Service, prSrv *pullrequests.Service, repoSrv *repository.Service, wf *actions.Service) {
+func startUpCliService(app *cli.App, cfg config.Config, urSrv *userrepos.Service, prSrv *pullrequests.Service, repoSrv *repository.Service, wf *actions.Service, teamsSrv *teams.Service, automationSrv *automation.Service, categoriesSrv *categories.Service, codeOwnersSrv *codeowners.Service, releaseNotesSrv *releasenotes.Service) {
     u := utils.New(cfg)
     tp := printer.NewTablePrinter()
 
-    b := command.NewBuilder(cfg, urSrv, prSrv, repoSrv, wf, tp, u)
+    b := command.NewBuilder(cfg, urSrv, prSrv, repoSrv, wf, teamsSrv, automationSrv, categoriesSrv, codeOwnersSrv, releaseNotesSrv, tp, u)
 
     app.Commands = b.
         Find().
@@ -83,10 +109,20 @@ func startUpCliService(app *cli.App, cfg config.Config, urSrv *userrepos.Service
         Widget().
         CommitList().
         CreateRelease().
+        Changelog().
+        Release().
         CreatedPullRequests().
         ReleaseReport().
         PublishPullRequestMetrics().
         Workflows().
+        Aging().
+        CycleTime().
+        Hotspots().
+        CodeOwners().
+        Knowledge().
+        ReviewGraph().
+        SuggestReviewers().
+        Inbox().
         GetCommands()
 
     err := app.Run(os.Args)
@@ -0,0 +1,88 @@
+package automation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const (
+	botUserType = "Bot"
+	botSuffix   = "[bot]"
+)
+
+// Service describes the automation service.
+type Service struct {
+	ignoredUsers   map[string]struct{}
+	excludedLabels map[string]struct{}
+}
+
+// NewService creates and returns a service instance.
+func NewService(cfg config.Config) *Service {
+	ignoredUsers := make(map[string]struct{})
+	for _, u := range cfg.Automation.IgnoredUsers {
+		ignoredUsers[strings.ToLower(u)] = struct{}{}
+	}
+
+	excludedLabels := make(map[string]struct{})
+	for _, l := range cfg.Automation.ExcludedLabels {
+		excludedLabels[strings.ToLower(l)] = struct{}{}
+	}
+
+	return &Service{
+		ignoredUsers:   ignoredUsers,
+		excludedLabels: excludedLabels,
+	}
+}
+
+// IsAutomatedUser checks whether a user is a bot or an automation account.
+func (s *Service) IsAutomatedUser(user domain.User) bool {
+	if user.Type == botUserType || strings.HasSuffix(user.Username, botSuffix) {
+		return true
+	}
+
+	_, ok := s.ignoredUsers[strings.ToLower(user.Username)]
+	return ok
+}
+
+// IsAutomatedPullRequest checks whether a pull request has been created by a bot or an automation account, or has
+// any of the labels that are used for automated changes.
+func (s *Service) IsAutomatedPullRequest(pullRequest domain.PullRequest) bool {
+	if s.IsAutomatedUser(pullRequest.Creator) {
+		return true
+	}
+
+	for _, l := range pullRequest.Labels {
+		if _, ok := s.excludedLabels[strings.ToLower(l.Name)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAutomationSummary prepares the summary of the provided automated pull requests.
+func (s *Service) GetAutomationSummary(automatedPullRequests []domain.PullRequest) domain.AutomationSummary {
+	summary := domain.AutomationSummary{
+		Authors: make(map[string]int),
+	}
+
+	var totalLeadTime time.Duration
+	for _, pr := range automatedPullRequests {
+		summary.PullRequests++
+		summary.Authors[pr.Creator.Username]++
+
+		if !pr.MergedAt.IsZero() {
+			summary.Merged++
+			totalLeadTime += pr.MergedAt.Sub(pr.CreatedAt)
+		}
+	}
+
+	if summary.Merged > 0 {
+		summary.AvgLeadTime = time.Duration(totalLeadTime.Seconds()/float64(summary.Merged)) * time.Second
+	}
+
+	return summary
+}
@@ -0,0 +1,79 @@
+package automation_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/app/infra/automation"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+func TestIsAutomatedPullRequest(t *testing.T) {
+	var cfg config.Config
+	cfg.Automation.IgnoredUsers = []string{"Renovate"}
+	cfg.Automation.ExcludedLabels = []string{"dependencies"}
+
+	srv := automation.NewService(cfg)
+
+	testCases := map[string]struct {
+		pullRequest domain.PullRequest
+		expected    bool
+	}{
+		"Pull request created by a user of type bot": {
+			pullRequest: domain.PullRequest{Creator: domain.User{Username: "some-app", Type: "Bot"}},
+			expected:    true,
+		},
+		"Pull request created by a user with bot suffix": {
+			pullRequest: domain.PullRequest{Creator: domain.User{Username: "dependabot[bot]", Type: "User"}},
+			expected:    true,
+		},
+		"Pull request created by an ignored user": {
+			pullRequest: domain.PullRequest{Creator: domain.User{Username: "renovate", Type: "User"}},
+			expected:    true,
+		},
+		"Pull request with an excluded label": {
+			pullRequest: domain.PullRequest{Creator: domain.User{Username: "alice", Type: "User"}, Labels: []domain.Label{{Name: "Dependencies"}}},
+			expected:    true,
+		},
+		"Pull request created by a human": {
+			pullRequest: domain.PullRequest{Creator: domain.User{Username: "alice", Type: "User"}, Labels: []domain.Label{{Name: "bug"}}},
+			expected:    false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := srv.IsAutomatedPullRequest(tc.pullRequest)
+
+			if tc.expected != actual {
+				t.Errorf("Expected to get '%v', but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetAutomationSummary(t *testing.T) {
+	srv := automation.NewService(config.Config{})
+	createdAt := time.Date(2021, 1, 6, 10, 0, 0, 0, time.UTC)
+
+	automatedPullRequests := []domain.PullRequest{
+		{Creator: domain.User{Username: "dependabot[bot]"}, CreatedAt: createdAt, MergedAt: createdAt.Add(2 * time.Hour)},
+		{Creator: domain.User{Username: "dependabot[bot]"}, CreatedAt: createdAt, MergedAt: createdAt.Add(4 * time.Hour)},
+		{Creator: domain.User{Username: "renovate[bot]"}, CreatedAt: createdAt},
+	}
+
+	expected := domain.AutomationSummary{
+		PullRequests: 3,
+		Merged:       2,
+		AvgLeadTime:  3 * time.Hour,
+		Authors:      map[string]int{"dependabot[bot]": 2, "renovate[bot]": 1},
+	}
+
+	actual := srv.GetAutomationSummary(automatedPullRequests)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%v' as summary, but got '%v'", expected, actual)
+	}
+}
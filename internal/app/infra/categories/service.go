@@ -0,0 +1,143 @@
+package categories
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// category describes the compiled rules of a category.
+type category struct {
+	name           string
+	labels         []*regexp.Regexp
+	titlePrefixes  []string
+	branchPatterns []*regexp.Regexp
+}
+
+// Service describes the categories service.
+type Service struct {
+	categories      []category
+	defaultCategory string
+}
+
+// NewService creates and returns a service instance. An error is returned in case any of the configured patterns is
+// not a valid regular expression.
+func NewService(cfg config.Config) (*Service, error) {
+	var categories []category
+	for _, c := range cfg.Categorisation.Categories {
+		labels, err := compilePatterns(c.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label pattern of category %q : %v", c.Name, err)
+		}
+
+		branchPatterns, err := compilePatterns(c.BranchPatterns)
+		if err != nil {
+			return nil, fmt.Errorf("invalid branch pattern of category %q : %v", c.Name, err)
+		}
+
+		var titlePrefixes []string
+		for _, p := range c.TitlePrefixes {
+			titlePrefixes = append(titlePrefixes, strings.ToLower(p))
+		}
+
+		categories = append(categories, category{
+			name:           c.Name,
+			labels:         labels,
+			titlePrefixes:  titlePrefixes,
+			branchPatterns: branchPatterns,
+		})
+	}
+
+	return &Service{
+		categories:      categories,
+		defaultCategory: cfg.Categorisation.DefaultCategory,
+	}, nil
+}
+
+// GetCategoryNames returns the names of all the categories in the order they have been defined, including the default one.
+func (s *Service) GetCategoryNames() []string {
+	var names []string
+	for _, c := range s.categories {
+		names = append(names, c.name)
+	}
+
+	return append(names, s.defaultCategory)
+}
+
+// GetCategory returns the first category that the pull request matches or the default category in case it matches none.
+func (s *Service) GetCategory(pullRequest domain.PullRequest) string {
+	for _, c := range s.categories {
+		if c.matches(pullRequest) {
+			return c.name
+		}
+	}
+
+	return s.defaultCategory
+}
+
+// matches checks whether the pull request matches any of the rules of the category.
+func (c category) matches(pullRequest domain.PullRequest) bool {
+	for _, l := range pullRequest.Labels {
+		for _, re := range c.labels {
+			if re.MatchString(l.Name) {
+				return true
+			}
+		}
+	}
+
+	title := strings.ToLower(pullRequest.Title)
+	for _, p := range c.titlePrefixes {
+		if hasTitlePrefix(title, p) {
+			return true
+		}
+	}
+
+	for _, re := range c.branchPatterns {
+		if re.MatchString(pullRequest.Head.Ref) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasTitlePrefix checks whether the title starts with the prefix as a whole word, so that a 'feat' prefix matches the
+// 'feat: ...' and 'feat(scope): ...' titles but not the ones starting with 'feature'.
+func hasTitlePrefix(title, prefix string) bool {
+	if !strings.HasPrefix(title, prefix) {
+		return false
+	}
+
+	lastRune, _ := utf8.DecodeLastRuneInString(prefix)
+	nextRune, size := utf8.DecodeRuneInString(title[len(prefix):])
+	if size == 0 || !isWordRune(lastRune) {
+		return true
+	}
+
+	return !isWordRune(nextRune)
+}
+
+// isWordRune checks whether the rune is a letter or a digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// compilePatterns compiles the provided patterns as case insensitive regular expressions.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, err
+		}
+
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
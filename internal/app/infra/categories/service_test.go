@@ -0,0 +1,83 @@
+package categories_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/app/infra/categories"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+func TestGetCategory(t *testing.T) {
+	var cfg config.Config
+	cfg.Categorisation.DefaultCategory = "other"
+	cfg.Categorisation.Categories = []config.Category{
+		{Name: "feature", Labels: []string{"^feature$"}, TitlePrefixes: []string{"feat"}, BranchPatterns: []string{"^feature/"}},
+		{Name: "bug", Labels: []string{"^bug$"}, TitlePrefixes: []string{"fix"}},
+	}
+
+	srv, err := categories.NewService(cfg)
+	if err != nil {
+		t.Fatalf("Expected to get nil as error, but got '%v'", err)
+	}
+
+	testCases := map[string]struct {
+		pullRequest domain.PullRequest
+		expected    string
+	}{
+		"Matching by label": {
+			pullRequest: domain.PullRequest{Title: "Some change", Labels: []domain.Label{{Name: "Bug"}}},
+			expected:    "bug",
+		},
+		"Matching by title prefix": {
+			pullRequest: domain.PullRequest{Title: "Fix: broken pagination"},
+			expected:    "bug",
+		},
+		"Matching by title prefix with a scope": {
+			pullRequest: domain.PullRequest{Title: "feat(cli): add command"},
+			expected:    "feature",
+		},
+		"Title prefix only matches whole words": {
+			pullRequest: domain.PullRequest{Title: "Feature flags cleanup"},
+			expected:    "other",
+		},
+		"Matching by branch name": {
+			pullRequest: domain.PullRequest{Title: "Add command", Head: domain.PullRequestBranch{Ref: "feature/new-command"}},
+			expected:    "feature",
+		},
+		"First matching category wins": {
+			pullRequest: domain.PullRequest{Title: "fix typo", Labels: []domain.Label{{Name: "feature"}}},
+			expected:    "feature",
+		},
+		"No matching category": {
+			pullRequest: domain.PullRequest{Title: "Update readme", Head: domain.PullRequestBranch{Ref: "docs/readme"}},
+			expected:    "other",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualCategory := srv.GetCategory(tc.pullRequest)
+
+			if tc.expected != actualCategory {
+				t.Errorf("Expected to get '%v' as category, but got '%v'", tc.expected, actualCategory)
+			}
+		})
+	}
+
+	expectedNames := []string{"feature", "bug", "other"}
+	if actualNames := srv.GetCategoryNames(); !reflect.DeepEqual(expectedNames, actualNames) {
+		t.Errorf("Expected to get '%v' as category names, but got '%v'", expectedNames, actualNames)
+	}
+}
+
+func TestNewServiceWithInvalidPattern(t *testing.T) {
+	var cfg config.Config
+	cfg.Categorisation.Categories = []config.Category{{Name: "broken", Labels: []string{"("}}}
+
+	_, err := categories.NewService(cfg)
+	if err == nil {
+		t.Errorf("Expected to get an error, but got nil")
+	}
+}
@@ -0,0 +1,318 @@
+package codeowners
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/utils"
+)
+
+const (
+	defaultPageSize = 100
+	approvedState   = "APPROVED"
+	blobType        = "blob"
+	defaultRef      = "HEAD"
+)
+
+// ErrNoCodeOwners is returned when a repository has no CODEOWNERS file in any of the locations that github looks for it.
+var ErrNoCodeOwners = errors.New("no CODEOWNERS file found")
+
+// reviewDecisionStates lists the review states that decide whether a reviewer has approved a pull request or not, since
+// any comment of a reviewer after an approval or a change request does not change it.
+var reviewDecisionStates = map[string]bool{
+	approvedState:       true,
+	"CHANGES_REQUESTED": true,
+	"DISMISSED":         true,
+}
+
+// codeOwnersLocations lists the paths that github looks for the CODEOWNERS file, in the order it does.
+var codeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+type resource interface {
+	GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error)
+	GetRepositoryTree(authToken, repoOwner, repository, ref string) (domain.RepositoryTree, error)
+	GetReviewStateOfPullRequest(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error)
+	GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error)
+}
+
+// Service describes the code owners service.
+type Service struct {
+	resource    resource
+	mutex       sync.Mutex
+	patterns    map[string]*regexp.Regexp
+	teamMembers map[string][]string
+}
+
+// NewService creates and returns a service instance.
+func NewService(resource resource) *Service {
+	return &Service{
+		resource:    resource,
+		patterns:    make(map[string]*regexp.Regexp),
+		teamMembers: make(map[string][]string),
+	}
+}
+
+// GetCodeOwners retrieves and parses the CODEOWNERS file of a repository at the provided reference, which defaults to
+// the default branch of the repository.
+func (s *Service) GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error) {
+	for _, path := range codeOwnersLocations {
+		content, err := s.resource.GetRepositoryContent(authToken, repoOwner, repository, path, getRef(ref))
+		if err != nil {
+			return domain.CodeOwners{}, err
+		}
+
+		if content.Content == "" {
+			continue
+		}
+
+		decodedContent, err := base64.StdEncoding.DecodeString(strings.Replace(content.Content, "\n", "", -1))
+		if err != nil {
+			return domain.CodeOwners{}, fmt.Errorf("failed to decode the content of %q with error : %v", path, err)
+		}
+
+		return ParseCodeOwners(path, string(decodedContent)), nil
+	}
+
+	return domain.CodeOwners{}, fmt.Errorf("%w in %v/%v", ErrNoCodeOwners, repoOwner, repository)
+}
+
+// ParseCodeOwners parses the content of a CODEOWNERS file. Empty lines and comments are skipped.
+func ParseCodeOwners(path, content string) domain.CodeOwners {
+	codeOwners := domain.CodeOwners{Path: path}
+
+	for idx, line := range strings.Split(content, "\n") {
+		if commentIdx := strings.Index(line, "#"); commentIdx >= 0 {
+			line = line[:commentIdx]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		codeOwners.Rules = append(codeOwners.Rules, domain.CodeOwnersRule{
+			Line:    idx + 1,
+			Pattern: fields[0],
+			Owners:  fields[1:],
+		})
+	}
+
+	return codeOwners
+}
+
+// GetFileOwners returns the owners of a file. As in github, the last matching rule takes precedence.
+func (s *Service) GetFileOwners(codeOwners domain.CodeOwners, filename string) []string {
+	for idx := len(codeOwners.Rules) - 1; idx >= 0; idx-- {
+		if s.getPatternRegexp(codeOwners.Rules[idx].Pattern).MatchString(filename) {
+			return codeOwners.Rules[idx].Owners
+		}
+	}
+
+	return []string{}
+}
+
+// GetPullRequestOwners returns the distinct owners of the files modified in a pull request.
+func (s *Service) GetPullRequestOwners(codeOwners domain.CodeOwners, files []domain.CommitFile) []string {
+	distinctOwners := make(map[string]bool)
+	owners := []string{}
+	for _, f := range files {
+		for _, owner := range s.GetFileOwners(codeOwners, f.Filename) {
+			if !distinctOwners[owner] {
+				distinctOwners[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+
+	sort.Strings(owners)
+
+	return owners
+}
+
+// GetRepositoryFiles returns the paths of all the files of a repository at the provided reference, which defaults to the
+// default branch of the repository.
+func (s *Service) GetRepositoryFiles(authToken, repoOwner, repository, ref string) ([]string, error) {
+	tree, err := s.resource.GetRepositoryTree(authToken, repoOwner, repository, getRef(ref))
+	if err != nil {
+		return []string{}, err
+	}
+
+	if tree.Truncated {
+		fmt.Printf("The tree of %v/%v is too large and has been truncated, so some files may be missing.\n", repoOwner, repository)
+	}
+
+	files := []string{}
+	for _, entry := range tree.Tree {
+		if entry.Type == blobType {
+			files = append(files, entry.Path)
+		}
+	}
+
+	return files, nil
+}
+
+// GetUnapprovedFiles checks the approvals of a pull request and returns the files that have not been approved by any
+// of their owners, along with the owners whose approval is missing. The files without owners are not reported.
+func (s *Service) GetUnapprovedFiles(authToken, repoOwner, repository string, pullRequestNumber int, codeOwners domain.CodeOwners, files []domain.CommitFile) ([]string, []string, error) {
+	reviews, err := s.resource.GetReviewStateOfPullRequest(authToken, repoOwner, repository, pullRequestNumber)
+	if err != nil {
+		return []string{}, []string{}, err
+	}
+
+	sort.Slice(reviews, func(i, j int) bool {
+		return reviews[i].SubmittedAt.Before(reviews[j].SubmittedAt)
+	})
+
+	latestStates := make(map[string]string)
+	for _, r := range reviews {
+		if !reviewDecisionStates[r.State] {
+			continue
+		}
+
+		latestStates[strings.ToLower(r.User.Username)] = r.State
+	}
+
+	approvers := make(map[string]bool)
+	for username, state := range latestStates {
+		if state == approvedState {
+			approvers[username] = true
+		}
+	}
+
+	unapprovedFiles := []string{}
+	missingOwners := make(map[string]bool)
+	for _, f := range files {
+		owners := s.GetFileOwners(codeOwners, f.Filename)
+		if len(owners) == 0 {
+			continue
+		}
+
+		approved := false
+		for _, owner := range owners {
+			members, err := s.GetOwnerMembers(authToken, owner)
+			if err != nil {
+				return []string{}, []string{}, err
+			}
+
+			for _, m := range members {
+				if approvers[strings.ToLower(m)] {
+					approved = true
+					break
+				}
+			}
+
+			if approved {
+				break
+			}
+		}
+
+		if !approved {
+			unapprovedFiles = append(unapprovedFiles, f.Filename)
+			for _, owner := range owners {
+				missingOwners[owner] = true
+			}
+		}
+	}
+
+	owners := []string{}
+	for owner := range missingOwners {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+
+	return unapprovedFiles, owners, nil
+}
+
+// GetOwnerMembers returns the usernames that can approve on behalf of an owner. The members of the teams are
+// retrieved from github once and are kept for the subsequent calls, while owners defined by their email cannot be
+// resolved to a username.
+func (s *Service) GetOwnerMembers(authToken, owner string) ([]string, error) {
+	if !strings.HasPrefix(owner, "@") {
+		return []string{}, nil
+	}
+
+	name := strings.TrimPrefix(owner, "@")
+	details := strings.Split(name, "/")
+	if len(details) != 2 {
+		return []string{name}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if members, ok := s.teamMembers[name]; ok {
+		return members, nil
+	}
+
+	members := []string{}
+	currentPage := 1
+	for {
+		teamMembers, err := s.resource.GetTeamMembers(authToken, details[0], details[1], defaultPageSize, currentPage)
+		if err != nil {
+			return []string{}, err
+		}
+
+		for _, u := range teamMembers {
+			members = append(members, u.Username)
+		}
+
+		if len(teamMembers) < defaultPageSize {
+			break
+		}
+
+		currentPage++
+	}
+
+	s.teamMembers[name] = members
+
+	return members, nil
+}
+
+// getPatternRegexp converts a CODEOWNERS pattern to a regular expression, following the gitignore rules that github
+// supports. A pattern that contains a slash other than a trailing one is relative to the root of the repository,
+// otherwise it matches at any level. A pattern that is not a valid glob is matched literally.
+func (s *Service) getPatternRegexp(pattern string) *regexp.Regexp {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if re, ok := s.patterns[pattern]; ok {
+		return re
+	}
+
+	p := strings.TrimSuffix(pattern, "/")
+
+	prefix := "^(?:.*/)?"
+	if strings.Contains(p, "/") {
+		prefix = "^"
+	}
+
+	suffix := "(?:/.*)?$"
+	if strings.HasSuffix(pattern, "/") {
+		suffix = "/.*$"
+	}
+
+	p = strings.TrimPrefix(p, "/")
+
+	re, err := regexp.Compile(prefix + utils.GlobToRegex(p) + suffix)
+	if err != nil {
+		re = regexp.MustCompile(prefix + regexp.QuoteMeta(p) + suffix)
+	}
+	s.patterns[pattern] = re
+
+	return re
+}
+
+// getRef returns the provided reference or the default branch of the repository in case it is empty.
+func getRef(ref string) string {
+	if ref == "" {
+		return defaultRef
+	}
+
+	return ref
+}
@@ -0,0 +1,224 @@
+package codeowners_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/app/infra/codeowners"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/test/mock"
+)
+
+var codeOwnersContent = `# Default owners
+*                @org/core
+
+/docs/           @alice
+*.md             @bob # markdown
+internal/**/db/  @org/data
+/vendor/
+`
+
+func TestParseCodeOwners(t *testing.T) {
+	expected := domain.CodeOwners{
+		Path: "CODEOWNERS",
+		Rules: []domain.CodeOwnersRule{
+			{Line: 2, Pattern: "*", Owners: []string{"@org/core"}},
+			{Line: 4, Pattern: "/docs/", Owners: []string{"@alice"}},
+			{Line: 5, Pattern: "*.md", Owners: []string{"@bob"}},
+			{Line: 6, Pattern: "internal/**/db/", Owners: []string{"@org/data"}},
+			{Line: 7, Pattern: "/vendor/", Owners: []string{}},
+		},
+	}
+
+	actual := codeowners.ParseCodeOwners("CODEOWNERS", codeOwnersContent)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as code owners, but got '%+v'", expected, actual)
+	}
+}
+
+func TestGetFileOwners(t *testing.T) {
+	srv := codeowners.NewService(&mock.Client{})
+	codeOwners := codeowners.ParseCodeOwners("CODEOWNERS", codeOwnersContent)
+
+	testCases := map[string]struct {
+		filename string
+		expected []string
+	}{
+		"Fall back to the default owners": {
+			filename: "cmd/main.go",
+			expected: []string{"@org/core"},
+		},
+		"Match a directory relative to the root": {
+			filename: "docs/setup/install.txt",
+			expected: []string{"@alice"},
+		},
+		"Later rule takes precedence": {
+			filename: "docs/README.md",
+			expected: []string{"@bob"},
+		},
+		"Match nested directories": {
+			filename: "internal/app/infra/db/migration.sql",
+			expected: []string{"@org/data"},
+		},
+		"Anchored directory does not match at other levels": {
+			filename: "pkg/docs/a.go",
+			expected: []string{"@org/core"},
+		},
+		"Rule without owners marks the file as unowned": {
+			filename: "vendor/lib/a.go",
+			expected: []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := srv.GetFileOwners(codeOwners, tc.filename)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%v' as owners, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetFileOwnersWithGlobs(t *testing.T) {
+	srv := codeowners.NewService(&mock.Client{})
+	codeOwners := codeowners.ParseCodeOwners("CODEOWNERS", "docs/v?/ @alice\n**/gen/*.go @bob\n")
+
+	testCases := map[string]struct {
+		filename string
+		expected []string
+	}{
+		"Question mark matches a single character": {
+			filename: "docs/v1/index.md",
+			expected: []string{"@alice"},
+		},
+		"Question mark does not match more characters": {
+			filename: "docs/v10/index.md",
+			expected: []string{},
+		},
+		"Double star matches any number of directories": {
+			filename: "api/v1/gen/user.go",
+			expected: []string{"@bob"},
+		},
+		"Double star matches no directories as well": {
+			filename: "gen/user.go",
+			expected: []string{"@bob"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := srv.GetFileOwners(codeOwners, tc.filename)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%v' as owners, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetCodeOwners(t *testing.T) {
+	client := &mock.Client{}
+	client.On("GetRepositoryContent", "token", "o", "r", ".github/CODEOWNERS", "master").Return(domain.RepositoryContent{}, nil)
+	client.On("GetRepositoryContent", "token", "o", "r", "CODEOWNERS", "master").Return(domain.RepositoryContent{Content: base64.StdEncoding.EncodeToString([]byte("* @alice\n"))}, nil)
+	srv := codeowners.NewService(client)
+
+	actual, actualError := srv.GetCodeOwners("token", "o", "r", "master")
+
+	expected := domain.CodeOwners{Path: "CODEOWNERS", Rules: []domain.CodeOwnersRule{{Line: 1, Pattern: "*", Owners: []string{"@alice"}}}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as code owners, but got '%+v'", expected, actual)
+	}
+	if actualError != nil {
+		t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+	}
+}
+
+func TestGetCodeOwnersWithoutFile(t *testing.T) {
+	client := &mock.Client{}
+	client.On("GetRepositoryContent", "token", "o", "r", ".github/CODEOWNERS", "main").Return(domain.RepositoryContent{}, nil)
+	client.On("GetRepositoryContent", "token", "o", "r", "CODEOWNERS", "main").Return(domain.RepositoryContent{}, nil)
+	client.On("GetRepositoryContent", "token", "o", "r", "docs/CODEOWNERS", "main").Return(domain.RepositoryContent{}, nil)
+	srv := codeowners.NewService(client)
+
+	_, actualError := srv.GetCodeOwners("token", "o", "r", "main")
+	if !errors.Is(actualError, codeowners.ErrNoCodeOwners) {
+		t.Errorf("Expected to get '%v' as error, but got '%v'", codeowners.ErrNoCodeOwners, actualError)
+	}
+}
+
+func TestGetUnapprovedFiles(t *testing.T) {
+	codeOwners := codeowners.ParseCodeOwners("CODEOWNERS", codeOwnersContent)
+	files := []domain.CommitFile{{Filename: "cmd/main.go"}, {Filename: "docs/README.md"}, {Filename: "vendor/a.go"}}
+	now := time.Now()
+
+	client := &mock.Client{}
+	client.On("GetReviewStateOfPullRequest", "token", "o", "r", 1).Return([]domain.PullRequestReview{
+		{State: "APPROVED", User: domain.User{Username: "bob"}, SubmittedAt: now.Add(-2 * time.Hour)},
+		{State: "CHANGES_REQUESTED", User: domain.User{Username: "carol"}, SubmittedAt: now.Add(-2 * time.Hour)},
+		{State: "APPROVED", User: domain.User{Username: "carol"}, SubmittedAt: now.Add(-time.Hour)},
+	}, nil)
+	client.On("GetTeamMembers", "token", "org", "core", 100, 1).Return([]domain.User{{Username: "dave"}}, nil)
+	srv := codeowners.NewService(client)
+
+	actualFiles, actualOwners, actualError := srv.GetUnapprovedFiles("token", "o", "r", 1, codeOwners, files)
+
+	expectedFiles := []string{"cmd/main.go"}
+	expectedOwners := []string{"@org/core"}
+	if !reflect.DeepEqual(expectedFiles, actualFiles) {
+		t.Errorf("Expected to get '%v' as unapproved files, but got '%v'", expectedFiles, actualFiles)
+	}
+	if !reflect.DeepEqual(expectedOwners, actualOwners) {
+		t.Errorf("Expected to get '%v' as missing owners, but got '%v'", expectedOwners, actualOwners)
+	}
+	if actualError != nil {
+		t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+	}
+}
+
+func TestGetUnapprovedFilesWithCommentAfterApproval(t *testing.T) {
+	codeOwners := codeowners.ParseCodeOwners("CODEOWNERS", codeOwnersContent)
+	files := []domain.CommitFile{{Filename: "docs/README.md"}}
+	now := time.Now()
+
+	client := &mock.Client{}
+	client.On("GetReviewStateOfPullRequest", "token", "o", "r", 1).Return([]domain.PullRequestReview{
+		{State: "APPROVED", User: domain.User{Username: "bob"}, SubmittedAt: now.Add(-2 * time.Hour)},
+		{State: "COMMENTED", User: domain.User{Username: "bob"}, SubmittedAt: now.Add(-time.Hour)},
+		{State: "PENDING", User: domain.User{Username: "bob"}},
+	}, nil)
+	srv := codeowners.NewService(client)
+
+	actualFiles, actualOwners, actualError := srv.GetUnapprovedFiles("token", "o", "r", 1, codeOwners, files)
+
+	if len(actualFiles) != 0 {
+		t.Errorf("Expected to get no unapproved files, but got '%v'", actualFiles)
+	}
+	if len(actualOwners) != 0 {
+		t.Errorf("Expected to get no missing owners, but got '%v'", actualOwners)
+	}
+	if actualError != nil {
+		t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+	}
+}
+
+func TestGetRepositoryFiles(t *testing.T) {
+	client := &mock.Client{}
+	client.On("GetRepositoryTree", "token", "o", "r", "HEAD").Return(domain.RepositoryTree{
+		Tree: []domain.RepositoryTreeEntry{{Path: "cmd", Type: "tree"}, {Path: "cmd/main.go", Type: "blob"}},
+	}, nil)
+	srv := codeowners.NewService(client)
+
+	actual, actualError := srv.GetRepositoryFiles("token", "o", "r", "")
+
+	expected := []string{"cmd/main.go"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%v' as files, but got '%v'", expected, actual)
+	}
+	if actualError != nil {
+		t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+	}
+}
@@ -1,27 +1,48 @@
 package pullrequests
 
 import (
+	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/eujoy/gitpr/internal/domain"
 )
 
+const (
+	filesPageSize    = 100
+	searchPageSize   = 100
+	timelinePageSize = 100
+)
+
 type resource interface {
+	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
+	GetIssueComments(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.Comment, error)
 	GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.CommitFile, error)
+	GetIssueTimeline(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.TimelineEvent, error)
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
 	GetReviewStateOfPullRequest(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error)
+	RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error
+	SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error)
+}
+
+type automation interface {
+	IsAutomatedPullRequest(pullRequest domain.PullRequest) bool
 }
 
 // Service describes the user repositories service.
 type Service struct {
-	resource resource
+	resource   resource
+	automation automation
 }
 
 // NewService creates and returns a service instance.
-func NewService(resource resource) *Service {
+func NewService(resource resource, automation automation) *Service {
 	return &Service{
-		resource: resource,
+		resource:   resource,
+		automation: automation,
 	}
 }
 
@@ -45,6 +66,62 @@ func (s *Service) GetPullRequestsDetails(authToken, repoOwner, repository string
 	return pullRequestDetails, nil
 }
 
+// GetPullRequestFiles retrieves all the files that have been modified in a pull request.
+func (s *Service) GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error) {
+	var pullRequestFiles []domain.CommitFile
+	currentPage := 1
+
+	for {
+		files, err := s.resource.GetPullRequestFiles(authToken, repoOwner, repository, pullRequestNumber, filesPageSize, currentPage)
+		if err != nil {
+			return []domain.CommitFile{}, err
+		}
+
+		pullRequestFiles = append(pullRequestFiles, files...)
+
+		if len(files) < filesPageSize {
+			break
+		}
+
+		currentPage++
+	}
+
+	return pullRequestFiles, nil
+}
+
+// GetPullRequestTimeline retrieves all the events of the timeline of a pull request.
+func (s *Service) GetPullRequestTimeline(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.TimelineEvent, error) {
+	var timelineEvents []domain.TimelineEvent
+	currentPage := 1
+
+	for {
+		events, err := s.resource.GetIssueTimeline(authToken, repoOwner, repository, pullRequestNumber, timelinePageSize, currentPage)
+		if err != nil {
+			return []domain.TimelineEvent{}, err
+		}
+
+		timelineEvents = append(timelineEvents, events...)
+
+		if len(events) < timelinePageSize {
+			break
+		}
+
+		currentPage++
+	}
+
+	return timelineEvents, nil
+}
+
+// GetPullRequestReviews retrieves the reviews that have been submitted on a pull request.
+func (s *Service) GetPullRequestReviews(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error) {
+	reviews, err := s.resource.GetReviewStateOfPullRequest(authToken, repoOwner, repository, pullRequestNumber)
+	if err != nil {
+		return []domain.PullRequestReview{}, err
+	}
+
+	return reviews, nil
+}
+
 // GetPullRequestsOfRepository retrieves the pull requests for a specified repo.
 // @todo Improve performance of the flow.
 func (s *Service) GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error) {
@@ -65,6 +142,206 @@ func (s *Service) GetPullRequestsOfRepository(authToken, repoOwner, repository,
 	return pullRequests, nil
 }
 
+// GetPullRequestActivity retrieves the latest push, review and comment that took place on a pull request and checks
+// whether the pull request is approved or has change requests that have not been addressed with a new push. The latest
+// push is the one of the head commit, since the commits of a pull request are only listed up to the first 250 of them.
+func (s *Service) GetPullRequestActivity(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequestActivity, error) {
+	pullRequestDetails, err := s.resource.GetPullRequestsDetails(authToken, repoOwner, repository, pullRequestNumber)
+	if err != nil {
+		return domain.PullRequestActivity{}, err
+	}
+
+	activity := domain.PullRequestActivity{
+		LastActivityAt: pullRequestDetails.CreatedAt,
+	}
+
+	if pullRequestDetails.Head.Sha != "" {
+		headCommit, err := s.resource.GetCommitDetails(authToken, repoOwner, repository, pullRequestDetails.Head.Sha)
+		if err != nil {
+			return domain.PullRequestActivity{}, err
+		}
+
+		activity.LastPushAt = headCommit.Details.Committer.Date
+	}
+
+	if pullRequestDetails.Comments > 0 {
+		lastCommentList, err := s.resource.GetIssueComments(authToken, repoOwner, repository, pullRequestNumber, 1, pullRequestDetails.Comments)
+		if err != nil {
+			return domain.PullRequestActivity{}, err
+		}
+
+		if len(lastCommentList) > 0 {
+			activity.LastCommentAt = lastCommentList[0].CreatedAt
+		}
+	}
+
+	reviews, err := s.resource.GetReviewStateOfPullRequest(authToken, repoOwner, repository, pullRequestNumber)
+	if err != nil {
+		return domain.PullRequestActivity{}, err
+	}
+
+	sort.Slice(reviews, func(i, j int) bool {
+		return reviews[i].SubmittedAt.Before(reviews[j].SubmittedAt)
+	})
+
+	latestReviews := map[string]domain.PullRequestReview{}
+	for _, r := range reviews {
+		if r.SubmittedAt.After(activity.LastReviewAt) {
+			activity.LastReviewAt = r.SubmittedAt
+		}
+
+		if r.State == "APPROVED" || r.State == "CHANGES_REQUESTED" {
+			latestReviews[r.User.Username] = r
+		}
+	}
+
+	approvals, changeRequests := 0, 0
+	for _, r := range latestReviews {
+		if r.State == "APPROVED" {
+			approvals++
+			continue
+		}
+
+		changeRequests++
+		if !activity.LastPushAt.After(r.SubmittedAt) {
+			activity.HasUnaddressedChangeRequests = true
+		}
+	}
+	activity.IsApproved = approvals > 0 && changeRequests == 0
+
+	for _, t := range []time.Time{activity.LastPushAt, activity.LastReviewAt, activity.LastCommentAt} {
+		if t.After(activity.LastActivityAt) {
+			activity.LastActivityAt = t
+		}
+	}
+
+	return activity, nil
+}
+
+// FilterAutomatedPullRequests splits the provided pull requests to the ones to be used and the ones that have been
+// created by bots or automation accounts. In case the bots are included, all the pull requests are kept.
+func (s *Service) FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest) {
+	var keptPullRequests, automatedPullRequests []domain.PullRequest
+	for _, pr := range pullRequests {
+		if s.automation.IsAutomatedPullRequest(pr) {
+			automatedPullRequests = append(automatedPullRequests, pr)
+			if !includeBots {
+				continue
+			}
+		}
+
+		keptPullRequests = append(keptPullRequests, pr)
+	}
+
+	return keptPullRequests, automatedPullRequests
+}
+
+// RequestReviewers requests the review of a pull request from the provided users.
+func (s *Service) RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error {
+	return s.resource.RequestReviewers(authToken, repoOwner, repository, pullRequestNumber, reviewers)
+}
+
+// SearchPullRequests retrieves all the pull requests that match a search query. The query is restricted to pull
+// requests regardless of the qualifiers it contains.
+func (s *Service) SearchPullRequests(authToken, query string) ([]domain.SearchIssue, error) {
+	var pullRequests []domain.SearchIssue
+	currentPage := 1
+
+	for {
+		searchResp, err := s.resource.SearchIssues(authToken, fmt.Sprintf("is:pr %v", query), searchPageSize, currentPage)
+		if err != nil {
+			return []domain.SearchIssue{}, err
+		}
+
+		pullRequests = append(pullRequests, searchResp.Items...)
+
+		if len(searchResp.Items) < searchPageSize || len(pullRequests) >= searchResp.TotalCount {
+			break
+		}
+
+		currentPage++
+	}
+
+	return pullRequests, nil
+}
+
+// GetReviewStatus returns the review status of a pull request, based on the latest review states of its reviewers.
+// A pull request is approved when it has at least one approval and no change requests, while it is pending when
+// none of its reviewers has approved or requested changes yet.
+func (s *Service) GetReviewStatus(pullRequest domain.PullRequest) string {
+	approvals := 0
+	for _, state := range pullRequest.ReviewStates {
+		if state == "CHANGES_REQUESTED" {
+			return domain.ReviewStatusChangesRequested
+		}
+
+		if state == "APPROVED" {
+			approvals++
+		}
+	}
+
+	if approvals > 0 {
+		return domain.ReviewStatusApproved
+	}
+
+	return domain.ReviewStatusPending
+}
+
+// FilterPullRequests keeps only the pull requests that have been created by any of the creators, have any of the
+// reviewers as a requested reviewer or reviewer, have any of the labels and have the review status of the filter.
+func (s *Service) FilterPullRequests(pullRequests []domain.PullRequest, filter domain.PullRequestFilter) []domain.PullRequest {
+	filteredPullRequests := []domain.PullRequest{}
+	for _, pr := range pullRequests {
+		if len(filter.Creators) > 0 && !containsName(filter.Creators, pr.Creator.Username) {
+			continue
+		}
+
+		if len(filter.Reviewers) > 0 {
+			isReviewer := false
+			for reviewer := range pr.ReviewStates {
+				isReviewer = isReviewer || containsName(filter.Reviewers, reviewer)
+			}
+			for _, reviewer := range pr.Reviewers {
+				isReviewer = isReviewer || containsName(filter.Reviewers, reviewer.Username)
+			}
+
+			if !isReviewer {
+				continue
+			}
+		}
+
+		if len(filter.Labels) > 0 {
+			hasLabel := false
+			for _, label := range pr.Labels {
+				hasLabel = hasLabel || containsName(filter.Labels, label.Name)
+			}
+
+			if !hasLabel {
+				continue
+			}
+		}
+
+		if filter.ReviewStatus != "" && s.GetReviewStatus(pr) != filter.ReviewStatus {
+			continue
+		}
+
+		filteredPullRequests = append(filteredPullRequests, pr)
+	}
+
+	return filteredPullRequests
+}
+
+// containsName checks whether a list of names contains a name, ignoring the case.
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getLatestReviewStatus retrieve the latest pull request reviews state.
 func getLatestReviewStatus(prReviewers []domain.User, reviews []domain.PullRequestReview) map[string]string {
 	latestReviewState := map[string]string{}
@@ -0,0 +1,274 @@
+package pullrequests_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/app/infra/automation"
+	"github.com/eujoy/gitpr/internal/app/infra/pullrequests"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/test/mock"
+)
+
+func TestGetReviewStatus(t *testing.T) {
+	testCases := map[string]struct {
+		reviewStates map[string]string
+		expected     string
+	}{
+		"No reviewers": {
+			reviewStates: map[string]string{},
+			expected:     domain.ReviewStatusPending,
+		},
+		"Only pending and commented reviews": {
+			reviewStates: map[string]string{"alice": "PENDING", "bob": "COMMENTED"},
+			expected:     domain.ReviewStatusPending,
+		},
+		"Approved by a reviewer": {
+			reviewStates: map[string]string{"alice": "PENDING", "bob": "APPROVED"},
+			expected:     domain.ReviewStatusApproved,
+		},
+		"Changes requested despite an approval": {
+			reviewStates: map[string]string{"alice": "CHANGES_REQUESTED", "bob": "APPROVED"},
+			expected:     domain.ReviewStatusChangesRequested,
+		},
+	}
+
+	srv := pullrequests.NewService(nil, nil)
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := srv.GetReviewStatus(domain.PullRequest{ReviewStates: tc.reviewStates})
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as review status, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFilterPullRequests(t *testing.T) {
+	pullRequests := []domain.PullRequest{
+		{Number: 1, Creator: domain.User{Username: "alice"}, Labels: []domain.Label{{Name: "bug"}}, ReviewStates: map[string]string{"bob": "APPROVED"}},
+		{Number: 2, Creator: domain.User{Username: "bob"}, Reviewers: []domain.User{{Username: "carol"}}, ReviewStates: map[string]string{"carol": "PENDING"}},
+		{Number: 3, Creator: domain.User{Username: "carol"}, Labels: []domain.Label{{Name: "Feature"}}, ReviewStates: map[string]string{"alice": "CHANGES_REQUESTED"}},
+	}
+
+	testCases := map[string]struct {
+		filter   domain.PullRequestFilter
+		expected []int
+	}{
+		"Empty filter": {
+			filter:   domain.PullRequestFilter{},
+			expected: []int{1, 2, 3},
+		},
+		"Filter by creators": {
+			filter:   domain.PullRequestFilter{Creators: []string{"Alice", "carol"}},
+			expected: []int{1, 3},
+		},
+		"Filter by reviewers": {
+			filter:   domain.PullRequestFilter{Reviewers: []string{"carol", "bob"}},
+			expected: []int{1, 2},
+		},
+		"Filter by labels": {
+			filter:   domain.PullRequestFilter{Labels: []string{"feature", "docs"}},
+			expected: []int{3},
+		},
+		"Filter by review status": {
+			filter:   domain.PullRequestFilter{ReviewStatus: domain.ReviewStatusPending},
+			expected: []int{2},
+		},
+		"Filter by all the criteria": {
+			filter:   domain.PullRequestFilter{Creators: []string{"alice", "carol"}, Reviewers: []string{"bob"}, Labels: []string{"bug"}, ReviewStatus: domain.ReviewStatusApproved},
+			expected: []int{1},
+		},
+		"No matching pull requests": {
+			filter:   domain.PullRequestFilter{Creators: []string{"dave"}},
+			expected: []int{},
+		},
+	}
+
+	srv := pullrequests.NewService(nil, nil)
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := []int{}
+			for _, pr := range srv.FilterPullRequests(pullRequests, tc.filter) {
+				actual = append(actual, pr.Number)
+			}
+
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%v' as pull requests, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFilterAutomatedPullRequests(t *testing.T) {
+	srv := pullrequests.NewService(nil, automation.NewService(config.Config{}))
+
+	human := domain.PullRequest{Number: 1, Creator: domain.User{Username: "alice"}}
+	bot := domain.PullRequest{Number: 2, Creator: domain.User{Username: "dependabot[bot]"}}
+
+	testCases := map[string]struct {
+		includeBots       bool
+		expectedKept      []domain.PullRequest
+		expectedAutomated []domain.PullRequest
+	}{
+		"Exclude the automated pull requests": {
+			includeBots:       false,
+			expectedKept:      []domain.PullRequest{human},
+			expectedAutomated: []domain.PullRequest{bot},
+		},
+		"Include the automated pull requests": {
+			includeBots:       true,
+			expectedKept:      []domain.PullRequest{human, bot},
+			expectedAutomated: []domain.PullRequest{bot},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualKept, actualAutomated := srv.FilterAutomatedPullRequests([]domain.PullRequest{human, bot}, tc.includeBots)
+
+			if !reflect.DeepEqual(tc.expectedKept, actualKept) {
+				t.Errorf("Expected to get '%v' as kept pull requests, but got '%v'", tc.expectedKept, actualKept)
+			}
+			if !reflect.DeepEqual(tc.expectedAutomated, actualAutomated) {
+				t.Errorf("Expected to get '%v' as automated pull requests, but got '%v'", tc.expectedAutomated, actualAutomated)
+			}
+		})
+	}
+}
+
+func TestGetPullRequestActivity(t *testing.T) {
+	createdAt := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	pushedAt := createdAt.Add(48 * time.Hour)
+
+	pullRequest := domain.PullRequest{Number: 7, CreatedAt: createdAt, Comments: 12, Commits: 300, Head: domain.PullRequestBranch{Sha: "head"}}
+	headCommit := domain.Commit{Sha: "head"}
+	headCommit.Details.Committer.Date = pushedAt
+
+	testCases := map[string]struct {
+		reviews  []domain.PullRequestReview
+		expected domain.PullRequestActivity
+	}{
+		"Approved pull request": {
+			reviews: []domain.PullRequestReview{
+				{User: domain.User{Username: "bob"}, State: "APPROVED", SubmittedAt: createdAt.Add(72 * time.Hour)},
+			},
+			expected: domain.PullRequestActivity{
+				LastPushAt:     pushedAt,
+				LastReviewAt:   createdAt.Add(72 * time.Hour),
+				LastCommentAt:  createdAt.Add(24 * time.Hour),
+				LastActivityAt: createdAt.Add(72 * time.Hour),
+				IsApproved:     true,
+			},
+		},
+		"Change request after the latest push": {
+			reviews: []domain.PullRequestReview{
+				{User: domain.User{Username: "bob"}, State: "APPROVED", SubmittedAt: createdAt.Add(time.Hour)},
+				{User: domain.User{Username: "carol"}, State: "CHANGES_REQUESTED", SubmittedAt: createdAt.Add(72 * time.Hour)},
+			},
+			expected: domain.PullRequestActivity{
+				LastPushAt:                   pushedAt,
+				LastReviewAt:                 createdAt.Add(72 * time.Hour),
+				LastCommentAt:                createdAt.Add(24 * time.Hour),
+				LastActivityAt:               createdAt.Add(72 * time.Hour),
+				HasUnaddressedChangeRequests: true,
+			},
+		},
+		"Change request addressed by a later push": {
+			reviews: []domain.PullRequestReview{
+				{User: domain.User{Username: "carol"}, State: "CHANGES_REQUESTED", SubmittedAt: createdAt.Add(time.Hour)},
+			},
+			expected: domain.PullRequestActivity{
+				LastPushAt:     pushedAt,
+				LastReviewAt:   createdAt.Add(time.Hour),
+				LastCommentAt:  createdAt.Add(24 * time.Hour),
+				LastActivityAt: pushedAt,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mock.Client{}
+			client.On("GetPullRequestsDetails", "token", "owner", "repo", 7).Return(pullRequest, nil)
+			client.On("GetCommitDetails", "token", "owner", "repo", "head").Return(headCommit, nil)
+			client.On("GetIssueComments", "token", "owner", "repo", 7, 1, 12).Return([]domain.Comment{{CreatedAt: createdAt.Add(24 * time.Hour)}}, nil)
+			client.On("GetReviewStateOfPullRequest", "token", "owner", "repo", 7).Return(tc.reviews, nil)
+
+			srv := pullrequests.NewService(client, nil)
+
+			actualActivity, actualError := srv.GetPullRequestActivity("token", "owner", "repo", 7)
+
+			if !reflect.DeepEqual(tc.expected, actualActivity) {
+				t.Errorf("Expected to get '%+v' as activity, but got '%+v'", tc.expected, actualActivity)
+			}
+			if actualError != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+			}
+		})
+	}
+
+	t.Run("Fail to retrieve the details of the pull request - expecting an error", func(t *testing.T) {
+		expectedError := errors.New("not found")
+		client := &mock.Client{}
+		client.On("GetPullRequestsDetails", "token", "owner", "repo", 7).Return(domain.PullRequest{}, expectedError)
+
+		srv := pullrequests.NewService(client, nil)
+
+		_, actualError := srv.GetPullRequestActivity("token", "owner", "repo", 7)
+
+		if !reflect.DeepEqual(expectedError, actualError) {
+			t.Errorf("Expected to get '%v' as error, but got '%v'", expectedError, actualError)
+		}
+	})
+}
+
+func TestGetPullRequestFiles(t *testing.T) {
+	firstPage := make([]domain.CommitFile, 100)
+	for idx := range firstPage {
+		firstPage[idx] = domain.CommitFile{Filename: fmt.Sprintf("file-%d.go", idx)}
+	}
+	secondPage := []domain.CommitFile{{Filename: "README.md"}}
+
+	t.Run("Retrieve the files of all the pages", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetPullRequestFiles", "token", "owner", "repo", 7, 100, 1).Return(firstPage, nil)
+		client.On("GetPullRequestFiles", "token", "owner", "repo", 7, 100, 2).Return(secondPage, nil)
+
+		srv := pullrequests.NewService(client, nil)
+
+		actualFiles, actualError := srv.GetPullRequestFiles("token", "owner", "repo", 7)
+
+		expectedFiles := append(append([]domain.CommitFile{}, firstPage...), secondPage...)
+		if !reflect.DeepEqual(expectedFiles, actualFiles) {
+			t.Errorf("Expected to get '%v' files, but got '%v'", len(expectedFiles), len(actualFiles))
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Fail to retrieve a page of the files - expecting an error", func(t *testing.T) {
+		expectedError := errors.New("server error")
+		client := &mock.Client{}
+		client.On("GetPullRequestFiles", "token", "owner", "repo", 7, 100, 1).Return(firstPage, nil)
+		client.On("GetPullRequestFiles", "token", "owner", "repo", 7, 100, 2).Return([]domain.CommitFile{}, expectedError)
+
+		srv := pullrequests.NewService(client, nil)
+
+		actualFiles, actualError := srv.GetPullRequestFiles("token", "owner", "repo", 7)
+
+		if len(actualFiles) != 0 {
+			t.Errorf("Expected to get no files, but got '%v'", len(actualFiles))
+		}
+		if !reflect.DeepEqual(expectedError, actualError) {
+			t.Errorf("Expected to get '%v' as error, but got '%v'", expectedError, actualError)
+		}
+	})
+}
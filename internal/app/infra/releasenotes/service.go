@@ -0,0 +1,284 @@
+package releasenotes
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+var releaseNotesTemplate = `{{- range .Sections }}
+## {{ .Title }}
+
+{{ range .PullRequests -}}
+- {{ .Title }} ([#{{ .Number }}]({{ .HtmlUrl }})) by @{{ .Creator.Username }}
+{{ end }}
+{{- end }}
+{{- if .Contributors }}
+## Contributors
+
+{{ range $i, $c := .Contributors }}{{ if $i }}, {{ end }}@{{ $c }}{{ end }}
+{{ end }}
+{{- if .FirstTimeContributors }}
+## New Contributors
+
+{{ range .FirstTimeContributors -}}
+- @{{ . }} made their first contribution
+{{ end }}
+{{- end }}`
+
+type resource interface {
+	GetCommitPullRequests(authToken, repoOwner, repository, commitSha string) ([]domain.PullRequest, error)
+	SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error)
+}
+
+type utilities interface {
+	RunConcurrently(count int, fn func(idx int) error) error
+}
+
+// section describes the compiled rules of a section of the release notes.
+type section struct {
+	title  string
+	labels []*regexp.Regexp
+}
+
+// Service describes the release notes service.
+type Service struct {
+	resource       resource
+	utilities      utilities
+	sections       []section
+	defaultSection string
+	excludedLabels []*regexp.Regexp
+}
+
+// NewService creates and returns a service instance. An error is returned in case any of the configured patterns is
+// not a valid regular expression.
+func NewService(resource resource, utilities utilities, cfg config.Config) (*Service, error) {
+	var sections []section
+	for _, s := range cfg.ReleaseNotes.Sections {
+		labels, err := compilePatterns(s.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label pattern of release notes section %q : %v", s.Title, err)
+		}
+
+		sections = append(sections, section{title: s.Title, labels: labels})
+	}
+
+	excludedLabels, err := compilePatterns(cfg.ReleaseNotes.ExcludedLabels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid excluded label pattern of release notes : %v", err)
+	}
+
+	return &Service{
+		resource:       resource,
+		utilities:      utilities,
+		sections:       sections,
+		defaultSection: cfg.ReleaseNotes.DefaultSection,
+		excludedLabels: excludedLabels,
+	}, nil
+}
+
+// BuildReleaseNotes builds the release notes out of the merged pull requests that the commits of the release belong to.
+func (s *Service) BuildReleaseNotes(authToken, repoOwner, repository, previousTag, tag string, commitList []domain.Commit) (domain.ReleaseNotes, error) {
+	pullRequests, err := s.GetMergedPullRequests(authToken, repoOwner, repository, commitList)
+	if err != nil {
+		return domain.ReleaseNotes{}, err
+	}
+
+	firstTimeContributors, err := s.GetFirstTimeContributors(authToken, repoOwner, repository, pullRequests)
+	if err != nil {
+		return domain.ReleaseNotes{}, err
+	}
+
+	return domain.ReleaseNotes{
+		Repository:            fmt.Sprintf("%v/%v", repoOwner, repository),
+		PreviousTag:           previousTag,
+		Tag:                   tag,
+		Sections:              s.GroupPullRequests(pullRequests),
+		Contributors:          s.GetContributors(pullRequests),
+		FirstTimeContributors: firstTimeContributors,
+	}, nil
+}
+
+// GetMergedPullRequests retrieves the merged pull requests that the commits are associated with. Each pull request is
+// listed once, in the order that its first commit appears in the list. The pull requests of the commits are retrieved
+// concurrently.
+func (s *Service) GetMergedPullRequests(authToken, repoOwner, repository string, commitList []domain.Commit) ([]domain.PullRequest, error) {
+	commitPullRequestLists := make([][]domain.PullRequest, len(commitList))
+	err := s.utilities.RunConcurrently(len(commitList), func(idx int) error {
+		commitPullRequests, err := s.resource.GetCommitPullRequests(authToken, repoOwner, repository, commitList[idx].Sha)
+		if err != nil {
+			return fmt.Errorf("failed to get the pull requests of commit %v : %v", commitList[idx].Sha, err)
+		}
+
+		commitPullRequestLists[idx] = commitPullRequests
+		return nil
+	})
+	if err != nil {
+		return []domain.PullRequest{}, err
+	}
+
+	pullRequests := []domain.PullRequest{}
+	listedPullRequests := make(map[int]bool)
+
+	for _, commitPullRequests := range commitPullRequestLists {
+		for _, pr := range commitPullRequests {
+			if pr.MergedAt.IsZero() || listedPullRequests[pr.Number] {
+				continue
+			}
+
+			listedPullRequests[pr.Number] = true
+			pullRequests = append(pullRequests, pr)
+		}
+	}
+
+	return pullRequests, nil
+}
+
+// GroupPullRequests groups the pull requests in the sections that their labels match, skipping the ones with any of
+// the excluded labels. Only the sections that list any pull request are returned, followed by the default section.
+func (s *Service) GroupPullRequests(pullRequests []domain.PullRequest) []domain.ReleaseNotesSection {
+	grouped := make(map[string][]domain.PullRequest)
+
+	for _, pr := range pullRequests {
+		if matchesAnyLabel(pr.Labels, s.excludedLabels) {
+			continue
+		}
+
+		title := s.defaultSection
+		for _, sec := range s.sections {
+			if matchesAnyLabel(pr.Labels, sec.labels) {
+				title = sec.title
+				break
+			}
+		}
+
+		grouped[title] = append(grouped[title], pr)
+	}
+
+	sections := []domain.ReleaseNotesSection{}
+	for _, sec := range s.sections {
+		if len(grouped[sec.title]) > 0 {
+			sections = append(sections, domain.ReleaseNotesSection{Title: sec.title, PullRequests: grouped[sec.title]})
+		}
+	}
+
+	if len(grouped[s.defaultSection]) > 0 {
+		sections = append(sections, domain.ReleaseNotesSection{Title: s.defaultSection, PullRequests: grouped[s.defaultSection]})
+	}
+
+	return sections
+}
+
+// GetFirstTimeContributors returns the authors of the pull requests whose only merged pull requests in the repository
+// are the provided ones. The pull requests that got merged after the provided ones are not taken into account, so that
+// the release notes of older releases list the same contributors.
+func (s *Service) GetFirstTimeContributors(authToken, repoOwner, repository string, pullRequests []domain.PullRequest) ([]string, error) {
+	var lastMergedAt time.Time
+	pullRequestsPerAuthor := make(map[string]int)
+	for _, pr := range pullRequests {
+		pullRequestsPerAuthor[pr.Creator.Username]++
+		if pr.MergedAt.After(lastMergedAt) {
+			lastMergedAt = pr.MergedAt
+		}
+	}
+
+	firstTimeContributors := []string{}
+	for _, author := range s.GetContributors(pullRequests) {
+		query := fmt.Sprintf("repo:%v/%v is:pr is:merged author:%v merged:<=%v", repoOwner, repository, author, lastMergedAt.UTC().Format("2006-01-02T15:04:05Z"))
+
+		searchResp, err := s.resource.SearchIssues(authToken, query, 1, 1)
+		if err != nil {
+			return []string{}, fmt.Errorf("failed to get the merged pull requests of %v : %v", author, err)
+		}
+
+		if searchResp.TotalCount <= pullRequestsPerAuthor[author] {
+			firstTimeContributors = append(firstTimeContributors, author)
+		}
+	}
+
+	return firstTimeContributors, nil
+}
+
+// PrintReleaseNotes renders the release notes using the provided template file or the default template in case no
+// file is provided. The helper functions of the commit list templates are available as well.
+func (s *Service) PrintReleaseNotes(releaseNotes domain.ReleaseNotes, templateFile string) (string, error) {
+	name, tmpl := "releaseNotesTemplate", releaseNotesTemplate
+	if templateFile != "" {
+		tmplBytes, err := ioutil.ReadFile(templateFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read template file %q : %v", templateFile, err)
+		}
+
+		name, tmpl = filepath.Base(templateFile), string(tmplBytes)
+	}
+
+	t, err := template.New(name).Funcs(changelog.TemplateFuncs()).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare template with error : %v", err)
+	}
+
+	var tpl bytes.Buffer
+	err = t.Execute(&tpl, releaseNotes)
+	if err != nil {
+		return "", fmt.Errorf("failed to print release notes with error : %v", err)
+	}
+
+	return strings.TrimSpace(tpl.String()), nil
+}
+
+// GetContributors returns the distinct authors of the pull requests, sorted by name.
+func (s *Service) GetContributors(pullRequests []domain.PullRequest) []string {
+	distinctAuthors := make(map[string]bool)
+
+	contributors := []string{}
+	for _, pr := range pullRequests {
+		if pr.Creator.Username == "" || distinctAuthors[pr.Creator.Username] {
+			continue
+		}
+
+		distinctAuthors[pr.Creator.Username] = true
+		contributors = append(contributors, pr.Creator.Username)
+	}
+
+	sort.Strings(contributors)
+
+	return contributors
+}
+
+// matchesAnyLabel checks whether any of the labels matches any of the patterns.
+func matchesAnyLabel(labels []domain.Label, patterns []*regexp.Regexp) bool {
+	for _, l := range labels {
+		for _, p := range patterns {
+			if p.MatchString(l.Name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// compilePatterns compiles the provided patterns, ignoring the case.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, err
+		}
+
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
@@ -0,0 +1,211 @@
+package releasenotes_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/app/infra/releasenotes"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/utils"
+	"github.com/eujoy/gitpr/test/mock"
+)
+
+func newConfig() config.Config {
+	var cfg config.Config
+	cfg.ReleaseNotes.DefaultSection = "Other Changes"
+	cfg.ReleaseNotes.ExcludedLabels = []string{"^skip-changelog$"}
+	cfg.ReleaseNotes.Sections = []config.ReleaseNotesSection{
+		{Title: "Features", Labels: []string{"^feature$"}},
+		{Title: "Fixes", Labels: []string{"^bug$"}},
+		{Title: "Documentation", Labels: []string{"^docs$"}},
+	}
+
+	return cfg
+}
+
+func pullRequest(number int, author string, labels ...string) domain.PullRequest {
+	pr := domain.PullRequest{
+		Number:   number,
+		Title:    "Title",
+		Creator:  domain.User{Username: author},
+		Labels:   []domain.Label{},
+		MergedAt: time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	for _, l := range labels {
+		pr.Labels = append(pr.Labels, domain.Label{Name: l})
+	}
+
+	return pr
+}
+
+func TestNewService(t *testing.T) {
+	cfg := newConfig()
+	cfg.ReleaseNotes.Sections = []config.ReleaseNotesSection{{Title: "Broken", Labels: []string{"("}}}
+
+	_, err := releasenotes.NewService(nil, utils.New(cfg), cfg)
+	if err == nil {
+		t.Errorf("Expected to get an error, but got nil")
+	}
+}
+
+func TestGetMergedPullRequests(t *testing.T) {
+	commitList := []domain.Commit{{Sha: "a"}, {Sha: "b"}, {Sha: "c"}}
+
+	t.Run("Deduplicate the merged pull requests of the commits", func(t *testing.T) {
+		openPullRequest := pullRequest(3, "carol")
+		openPullRequest.MergedAt = time.Time{}
+
+		client := &mock.Client{}
+		client.On("GetCommitPullRequests", "token", "o", "r", "a").Return([]domain.PullRequest{pullRequest(1, "alice")}, nil)
+		client.On("GetCommitPullRequests", "token", "o", "r", "b").Return([]domain.PullRequest{pullRequest(1, "alice"), openPullRequest}, nil)
+		client.On("GetCommitPullRequests", "token", "o", "r", "c").Return([]domain.PullRequest{pullRequest(2, "bob")}, nil)
+
+		cfg := newConfig()
+		cfg.Settings.MaxConcurrentRequests = 3
+		srv, _ := releasenotes.NewService(client, utils.New(cfg), cfg)
+
+		pullRequests, err := srv.GetMergedPullRequests("token", "o", "r", commitList)
+
+		actual := []int{}
+		for _, pr := range pullRequests {
+			actual = append(actual, pr.Number)
+		}
+
+		expected := []int{1, 2}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as pull requests, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Fail to retrieve the pull requests of a commit - expecting an error", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetCommitPullRequests", "token", "o", "r", "a").Return([]domain.PullRequest{}, errors.New("not found"))
+		srv, _ := releasenotes.NewService(client, utils.New(newConfig()), newConfig())
+
+		_, err := srv.GetMergedPullRequests("token", "o", "r", commitList)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGroupPullRequests(t *testing.T) {
+	pullRequests := []domain.PullRequest{
+		pullRequest(1, "alice", "Bug"),
+		pullRequest(2, "bob"),
+		pullRequest(3, "carol", "feature", "bug"),
+		pullRequest(4, "alice", "docs", "skip-changelog"),
+		pullRequest(5, "bob", "feature"),
+	}
+
+	srv, _ := releasenotes.NewService(nil, utils.New(newConfig()), newConfig())
+
+	actual := map[string][]int{}
+	actualOrder := []string{}
+	for _, s := range srv.GroupPullRequests(pullRequests) {
+		actualOrder = append(actualOrder, s.Title)
+		for _, pr := range s.PullRequests {
+			actual[s.Title] = append(actual[s.Title], pr.Number)
+		}
+	}
+
+	expectedOrder := []string{"Features", "Fixes", "Other Changes"}
+	if !reflect.DeepEqual(expectedOrder, actualOrder) {
+		t.Errorf("Expected to get '%v' as sections, but got '%v'", expectedOrder, actualOrder)
+	}
+
+	expected := map[string][]int{"Features": {3, 5}, "Fixes": {1}, "Other Changes": {2}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%v' as grouped pull requests, but got '%v'", expected, actual)
+	}
+}
+
+func TestGetFirstTimeContributors(t *testing.T) {
+	pullRequests := []domain.PullRequest{pullRequest(1, "bob"), pullRequest(2, "alice"), pullRequest(3, "bob")}
+
+	client := &mock.Client{}
+	client.On("SearchIssues", "token", "repo:o/r is:pr is:merged author:alice merged:<=2021-03-01T09:00:00Z", 1, 1).Return(domain.SearchIssuesResponse{TotalCount: 12}, nil)
+	client.On("SearchIssues", "token", "repo:o/r is:pr is:merged author:bob merged:<=2021-03-01T09:00:00Z", 1, 1).Return(domain.SearchIssuesResponse{TotalCount: 2}, nil)
+	srv, _ := releasenotes.NewService(client, utils.New(newConfig()), newConfig())
+
+	actual, err := srv.GetFirstTimeContributors("token", "o", "r", pullRequests)
+
+	expected := []string{"bob"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%v' as first time contributors, but got '%v'", expected, actual)
+	}
+	if err != nil {
+		t.Errorf("Expected to get nil as error, but got '%v'", err)
+	}
+
+	expectedContributors := []string{"alice", "bob"}
+	if actualContributors := srv.GetContributors(pullRequests); !reflect.DeepEqual(expectedContributors, actualContributors) {
+		t.Errorf("Expected to get '%v' as contributors, but got '%v'", expectedContributors, actualContributors)
+	}
+}
+
+func TestPrintReleaseNotes(t *testing.T) {
+	releaseNotes := domain.ReleaseNotes{
+		Tag: "v1.1.0",
+		Sections: []domain.ReleaseNotesSection{
+			{Title: "Fixes", PullRequests: []domain.PullRequest{{Number: 1, Title: "Don't panic", HtmlUrl: "https://github.com/o/r/pull/1", Creator: domain.User{Username: "alice"}}}},
+		},
+		Contributors:          []string{"alice"},
+		FirstTimeContributors: []string{"alice"},
+	}
+
+	srv, _ := releasenotes.NewService(nil, utils.New(newConfig()), newConfig())
+
+	t.Run("Print with the default template", func(t *testing.T) {
+		actual, err := srv.PrintReleaseNotes(releaseNotes, "")
+
+		expected := "## Fixes\n\n- Don't panic ([#1](https://github.com/o/r/pull/1)) by @alice\n\n## Contributors\n\n@alice\n\n## New Contributors\n\n- @alice made their first contribution"
+		if expected != actual {
+			t.Errorf("Expected to get '%v' as release notes, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Print with a template file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "releasenotes")
+		if err != nil {
+			t.Fatalf("Failed to create temporary directory : %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		templateFile := filepath.Join(dir, "notes.tmpl")
+		err = ioutil.WriteFile(templateFile, []byte("{{ .Tag }}:{{ range .Sections }} {{ .Title }}{{ end }}"), 0644)
+		if err != nil {
+			t.Fatalf("Failed to write template file : %v", err)
+		}
+
+		actual, err := srv.PrintReleaseNotes(releaseNotes, templateFile)
+
+		expected := "v1.1.0: Fixes"
+		if expected != actual {
+			t.Errorf("Expected to get '%v' as release notes, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Provide a template file that does not exist - expecting an error", func(t *testing.T) {
+		_, err := srv.PrintReleaseNotes(releaseNotes, "does-not-exist.tmpl")
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
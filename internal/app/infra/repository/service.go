@@ -2,10 +2,25 @@ package repository
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
-	"html/template"
+	"io"
+	"io/ioutil"
+	"mime"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
 
+	"github.com/eujoy/gitpr/internal/config"
 	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+const (
+	commitPageSize  = 100
+	releasePageSize = 100
 )
 
 var commitListTerminalTemplate = `Commit List :
@@ -21,28 +36,117 @@ var commitListReleaseTemplate = `Commits included from last release :
 - [ ] (@{{ .Author.Username }}) | {{ .Details.Message }}
 {{- end}}`
 
+var commitListChangelogTemplate = `{{- define "entry" }}
+- {{ if .Scope }}**{{ .Scope }}:** {{ end }}{{ .Description }} ([{{ .ShortSha }}]({{ .Url }}))
+  {{- if .PullRequestNumber }} ([#{{ .PullRequestNumber }}]({{ .PullRequestUrl }})){{ end }}
+  {{- if .Author }} (@{{ .Author }}){{ end }}
+{{- end -}}
+
+{{- if .BreakingChanges }}
+## Breaking Changes
+{{ range .BreakingChanges }}
+- {{ if .Scope }}**{{ .Scope }}:** {{ end }}{{ .BreakingDescription }} ([{{ .ShortSha }}]({{ .Url }}))
+{{- end }}
+{{ end }}
+{{- if .Features }}
+## Features
+{{ range .Features }}{{ template "entry" . }}{{ end }}
+{{ end }}
+{{- if .Fixes }}
+## Fixes
+{{ range .Fixes }}{{ template "entry" . }}{{ end }}
+{{ end }}
+{{- if .Other }}
+## Other
+{{ range .Other }}{{ template "entry" . }}{{ end }}
+{{ end }}`
+
+var commitListMarkdownTemplate = `## Commits
+{{ range $c := . }}
+- {{ $c.Details.Message | firstLine }} ([{{ shortSha $c.Sha }}]({{ $c.HtmlUrl }}))
+  {{- with prNumber $c }} ([#{{ . }}]({{ prLink $c }})){{ end }}
+  {{- if $c.Author.Username }} (@{{ $c.Author.Username }}){{ end }}
+{{- end }}`
+
+var commitListSlackTemplate = `*Commits*
+{{ range $c := . }}
+• <{{ $c.HtmlUrl }}|{{ shortSha $c.Sha }}> {{ $c.Details.Message | firstLine }}
+  {{- with prNumber $c }} (<{{ prLink $c }}|#{{ . }}>){{ end }}
+  {{- if $c.Author.Username }} by {{ $c.Author.Username }}{{ end }}
+{{- end }}`
+
+var commitListPlainTemplate = `{{- range $idx, $group := groupByAuthor . }}
+{{- if $idx }}
+
+{{ end }}
+{{- $group.Name }} ({{ len $group.Commits }}):
+{{- range $group.Commits }}
+  {{ shortSha .Sha }} {{ .Details.Message | firstLine | truncate 72 }}
+{{- end }}
+{{- end }}`
+
 type resource interface {
 	CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error
 	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
+	GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
+	GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
 	GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
+	UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error)
+	DeleteRelease(authToken, repoOwner, repository string, releaseID int) error
+	UploadReleaseAsset(authToken, repoOwner, repository string, releaseID int, name, contentType string, content io.Reader, size int64) (domain.ReleaseAsset, error)
+	DeleteReleaseAsset(authToken, repoOwner, repository string, assetID int) error
+	GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error)
+	GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error)
+	GetBranchReference(authToken, repoOwner, repository, branch string) (domain.GitReference, error)
+	CreateReference(authToken, repoOwner, repository, branch, sha string) (domain.GitReference, error)
+	UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha string) (domain.RepositoryContentUpdate, error)
+	CreatePullRequest(authToken, repoOwner, repository, title, head, base, body string) (domain.PullRequest, error)
 }
 
 // Service describes the user repositories service.
 type Service struct {
-	resource     resource
-	templateList map[string]string
+	resource      resource
+	templateList  map[string]string
+	userTemplates map[string]bool
 }
 
-// NewService creates and returns a service instance.
-func NewService(resource resource) *Service {
-	return &Service{
-		resource: resource,
-		templateList: map[string]string{
-			domain.CommitListTerminalTemplate: commitListTerminalTemplate,
-			domain.CommitListReleaseTemplate:  commitListReleaseTemplate,
-		},
+// NewService creates and returns a service instance. The templates of the configured directory are loaded along with
+// the default ones, which they override in case they have the same name.
+func NewService(resource resource, cfg config.Config) (*Service, error) {
+	templateList := map[string]string{
+		domain.CommitListTerminalTemplate:  commitListTerminalTemplate,
+		domain.CommitListReleaseTemplate:   commitListReleaseTemplate,
+		domain.CommitListChangelogTemplate: commitListChangelogTemplate,
+		domain.CommitListMarkdownTemplate:  commitListMarkdownTemplate,
+		domain.CommitListSlackTemplate:     commitListSlackTemplate,
+		domain.CommitListPlainTemplate:     commitListPlainTemplate,
 	}
+	userTemplates := make(map[string]bool)
+
+	if cfg.Templates.Directory != "" {
+		templateFiles, err := filepath.Glob(filepath.Join(cfg.Templates.Directory, "*.tmpl"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid templates directory %q : %v", cfg.Templates.Directory, err)
+		}
+
+		for _, f := range templateFiles {
+			tmplBytes, err := ioutil.ReadFile(f)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read template file %q : %v", f, err)
+			}
+
+			name := strings.TrimSuffix(filepath.Base(f), ".tmpl")
+			templateList[name] = string(tmplBytes)
+			userTemplates[name] = true
+		}
+	}
+
+	return &Service{
+		resource:      resource,
+		templateList:  templateList,
+		userTemplates: userTemplates,
+	}, nil
 }
 
 // GetCommitDetails to get the details of a commit.
@@ -51,6 +155,83 @@ func (s *Service) GetCommitDetails(authToken, repoOwner, repository, commitSha s
 	return commitDetails, err
 }
 
+// GetCommitList retrieves all the commits of a branch that have been created during a specific time period. The merge
+// commits are skipped, since their changes are already part of the commits that have been merged.
+func (s *Service) GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time) ([]domain.Commit, error) {
+	var commitList []domain.Commit
+	for pageNumber := 1; ; pageNumber++ {
+		commits, err := s.resource.GetCommitList(authToken, repoOwner, repository, branch, since, until, commitPageSize, pageNumber)
+		if err != nil {
+			return []domain.Commit{}, err
+		}
+
+		for _, c := range commits {
+			if len(c.Parents) > 1 {
+				continue
+			}
+
+			commitList = append(commitList, c)
+		}
+
+		if len(commits) < commitPageSize {
+			return commitList, nil
+		}
+	}
+}
+
+// GetCommitsWithFiles retrieves the commits between two tags or, in case no start tag is provided, the commits of a
+// branch during a time period along with the files they modified. The commits for which skipCommit returns true are not
+// retrieved and the files for which isExcluded returns true are dropped. Both functions are optional.
+func (s *Service) GetCommitsWithFiles(authToken, repoOwner, repository, branch, startTag, endTag string, since, until time.Time, skipCommit func(commit domain.Commit) bool, isExcluded func(filename string) bool) ([]domain.Commit, error) {
+	var commitList []domain.Commit
+	if startTag != "" {
+		diff, err := s.resource.GetDiffBetweenTags(authToken, repoOwner, repository, startTag, endTag)
+		if err != nil {
+			return []domain.Commit{}, err
+		}
+
+		commitList = diff.Commits
+	} else {
+		var err error
+		commitList, err = s.GetCommitList(authToken, repoOwner, repository, branch, since, until)
+		if err != nil {
+			return []domain.Commit{}, err
+		}
+	}
+
+	var detailedCommits []domain.Commit
+	for _, commitItem := range commitList {
+		if skipCommit != nil && skipCommit(commitItem) {
+			continue
+		}
+
+		commitDetails, err := s.resource.GetCommitDetails(authToken, repoOwner, repository, commitItem.Sha)
+		if err != nil {
+			return []domain.Commit{}, fmt.Errorf("failed to get the details of commit %v : %v", commitItem.Sha, err)
+		}
+
+		if isExcluded != nil {
+			var files []domain.CommitFile
+			for _, f := range commitDetails.Files {
+				if !isExcluded(f.Filename) {
+					files = append(files, f)
+				}
+			}
+			commitDetails.Files = files
+		}
+
+		detailedCommits = append(detailedCommits, commitDetails)
+	}
+
+	return detailedCommits, nil
+}
+
+// GetFileCommitList to get the commits of a branch that have modified a specific file since a point in time.
+func (s *Service) GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	commitList, err := s.resource.GetFileCommitList(authToken, repoOwner, repository, branch, path, since, pageSize, pageNumber)
+	return commitList, err
+}
+
 // GetDiffBetweenTags to get a list of commits.
 func (s *Service) GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error) {
 	commitList, err := s.resource.GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag)
@@ -69,20 +250,198 @@ func (s *Service) GetReleaseList(authToken, repoOwner, repository string, pageSi
 	return releaseList, err
 }
 
-// PrintCommitList converts a list of commits to a checklist.
+// GetReleaseByTag retrieves the release of the provided tag, going through the releases of the repository, since the
+// draft releases cannot be retrieved by their tag.
+func (s *Service) GetReleaseByTag(authToken, repoOwner, repository, tag string) (domain.Release, error) {
+	for pageNumber := 1; ; pageNumber++ {
+		releaseList, err := s.resource.GetReleaseList(authToken, repoOwner, repository, releasePageSize, pageNumber)
+		if err != nil {
+			return domain.Release{}, err
+		}
+
+		for _, r := range releaseList {
+			if r.TagName == tag {
+				return r, nil
+			}
+		}
+
+		if len(releaseList) < releasePageSize {
+			return domain.Release{}, fmt.Errorf("release of tag %q was not found", tag)
+		}
+	}
+}
+
+// UpdateRelease updates the provided fields of a release and returns the updated release.
+func (s *Service) UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error) {
+	release, err := s.resource.UpdateRelease(authToken, repoOwner, repository, releaseID, releaseUpdate)
+	if err != nil {
+		return domain.Release{}, err
+	}
+
+	if release.ID == 0 {
+		return domain.Release{}, fmt.Errorf("failed to update release %v", releaseID)
+	}
+
+	return release, nil
+}
+
+// DeleteRelease deletes a release, leaving its tag as it is.
+func (s *Service) DeleteRelease(authToken, repoOwner, repository string, releaseID int) error {
+	err := s.resource.DeleteRelease(authToken, repoOwner, repository, releaseID)
+	return err
+}
+
+// UploadReleaseAsset uploads the content as an asset of the release. In case the release already has an asset of the
+// same name, it is deleted first when it needs to be replaced, otherwise the upload is skipped and the existing asset
+// is returned instead. Whether the content got uploaded is returned as well.
+func (s *Service) UploadReleaseAsset(authToken, repoOwner, repository string, release domain.Release, name string, content io.Reader, size int64, replace bool) (domain.ReleaseAsset, bool, error) {
+	for _, a := range release.Assets {
+		if a.Name != name {
+			continue
+		}
+
+		if !replace {
+			return a, false, nil
+		}
+
+		err := s.resource.DeleteReleaseAsset(authToken, repoOwner, repository, a.ID)
+		if err != nil {
+			return domain.ReleaseAsset{}, false, err
+		}
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	releaseAsset, err := s.resource.UploadReleaseAsset(authToken, repoOwner, repository, release.ID, name, contentType, content, size)
+	if err != nil {
+		return domain.ReleaseAsset{}, false, err
+	}
+
+	if releaseAsset.ID == 0 {
+		return domain.ReleaseAsset{}, false, fmt.Errorf("failed to upload asset %q", name)
+	}
+
+	return releaseAsset, true, nil
+}
+
+// GetTagList fetches the tags of a repository.
+func (s *Service) GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error) {
+	tagList, err := s.resource.GetTagList(authToken, repoOwner, repository, pageSize, pageNumber)
+	return tagList, err
+}
+
+// GetFileContent retrieves the decoded content of a file of a repository at a specific reference along with the sha of
+// the file, which is required in order to update it. In case the file does not exist, both of them are empty.
+func (s *Service) GetFileContent(authToken, repoOwner, repository, path, ref string) (string, string, error) {
+	content, err := s.resource.GetRepositoryContent(authToken, repoOwner, repository, path, ref)
+	if err != nil {
+		return "", "", err
+	}
+
+	if content.Sha == "" {
+		return "", "", nil
+	}
+
+	decodedContent, err := base64.StdEncoding.DecodeString(strings.Replace(content.Content, "\n", "", -1))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode the content of %q : %v", path, err)
+	}
+
+	return string(decodedContent), content.Sha, nil
+}
+
+// CreatePullRequestWithFile creates a new branch out of the base branch, commits the content of the file to it and opens
+// a pull request from it towards the base branch. The sha of the existing file is required in order to update it.
+func (s *Service) CreatePullRequestWithFile(authToken, repoOwner, repository, baseBranch, headBranch, path, content, sha, message, title, body string) (domain.PullRequest, error) {
+	baseReference, err := s.resource.GetBranchReference(authToken, repoOwner, repository, baseBranch)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if baseReference.Object.Sha == "" {
+		return domain.PullRequest{}, fmt.Errorf("branch %q was not found", baseBranch)
+	}
+
+	headReference, err := s.resource.CreateReference(authToken, repoOwner, repository, headBranch, baseReference.Object.Sha)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if headReference.Ref == "" {
+		return domain.PullRequest{}, fmt.Errorf("failed to create branch %q", headBranch)
+	}
+
+	contentUpdate, err := s.resource.UpdateRepositoryContent(authToken, repoOwner, repository, path, headBranch, message, content, sha)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if contentUpdate.Commit.Sha == "" {
+		return domain.PullRequest{}, fmt.Errorf("failed to commit %q to branch %q", path, headBranch)
+	}
+
+	pullRequest, err := s.resource.CreatePullRequest(authToken, repoOwner, repository, title, headBranch, baseBranch, body)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if pullRequest.Number == 0 {
+		return domain.PullRequest{}, fmt.Errorf("failed to create pull request from branch %q", headBranch)
+	}
+
+	return pullRequest, nil
+}
+
+// PrintCommitList converts a list of commits to text using the template of the provided name. In case of the default
+// changelog template, the commits are grouped based on their conventional commit messages first, while the templates
+// of the configured directory always get the list of commits.
 func (s *Service) PrintCommitList(commitList []domain.Commit, useTmpl string) (string, error) {
-	t, err := template.New("outputTemplate").Parse(s.templateList[useTmpl])
+	tmpl, ok := s.templateList[useTmpl]
+	if !ok {
+		err := fmt.Errorf("template %q is not one of the available templates %v", useTmpl, s.GetTemplateNames())
+		fmt.Println(err)
+		return "", err
+	}
+
+	isChangelog := useTmpl == domain.CommitListChangelogTemplate && !s.userTemplates[useTmpl]
+
+	var data interface{} = commitList
+	if isChangelog {
+		data = changelog.BuildChangelog(commitList)
+	}
+
+	t, err := template.New("outputTemplate").Funcs(changelog.TemplateFuncs()).Parse(tmpl)
 	if err != nil {
 		fmt.Printf("Failed to prepare template with error : %v\n", err)
 		return "", err
 	}
 
 	var tpl bytes.Buffer
-	err = t.Execute(&tpl, commitList)
+	err = t.Execute(&tpl, data)
 	if err != nil {
 		fmt.Printf("Failed to print text with error : %v\n", err)
 		return "", err
 	}
 
+	// The changelog is trimmed, since the sections of the template that have no commits leave blank lines around it.
+	if isChangelog {
+		return strings.TrimSpace(tpl.String()), nil
+	}
+
 	return tpl.String(), nil
 }
+
+// GetTemplateNames returns the names of the available templates, sorted by name.
+func (s *Service) GetTemplateNames() []string {
+	var names []string
+	for name := range s.templateList {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,394 @@
+package repository_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/app/infra/repository"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/test/mock"
+	testifyMock "github.com/stretchr/testify/mock"
+)
+
+func TestPrintCommitList(t *testing.T) {
+	commitList := []domain.Commit{
+		{
+			Sha:     "1111111aaaa",
+			HtmlUrl: "https://github.com/o/r/commit/1111111aaaa",
+			Author:  domain.User{Username: "alice"},
+			Details: domain.CommitDetails{Message: "fix: don't panic on <nil> (#5)\n\nDetails.", Committer: domain.Committer{Date: time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)}},
+		},
+		{
+			Sha:     "2222222bbbb",
+			HtmlUrl: "https://github.com/o/r/commit/2222222bbbb",
+			Author:  domain.User{Username: "bob"},
+			Details: domain.CommitDetails{Message: "Update README.md", Committer: domain.Committer{Date: time.Date(2021, 3, 2, 9, 0, 0, 0, time.UTC)}},
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory : %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "short.tmpl"), []byte("{{ range . }}{{ shortSha .Sha }} {{ end }}"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write template file : %v", err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, domain.CommitListChangelogTemplate+".tmpl"), []byte("{{ range groupByAuthor . }}{{ .Name }} {{ end }}"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write template file : %v", err)
+	}
+
+	var cfg config.Config
+	cfg.Templates.Directory = dir
+
+	srv, err := repository.NewService(nil, cfg)
+	if err != nil {
+		t.Fatalf("Expected to get nil as error, but got '%v'", err)
+	}
+
+	testCases := map[string]struct {
+		template string
+		expected string
+	}{
+		"Release template without escaping": {
+			template: domain.CommitListReleaseTemplate,
+			expected: "Commits included from last release :\n- [ ] (@alice) | fix: don't panic on <nil> (#5)\n\nDetails.\n- [ ] (@bob) | Update README.md",
+		},
+		"Markdown template": {
+			template: domain.CommitListMarkdownTemplate,
+			expected: "## Commits\n\n- fix: don't panic on <nil> (#5) ([1111111](https://github.com/o/r/commit/1111111aaaa)) ([#5](https://github.com/o/r/pull/5)) (@alice)\n- Update README.md ([2222222](https://github.com/o/r/commit/2222222bbbb)) (@bob)",
+		},
+		"Slack template": {
+			template: domain.CommitListSlackTemplate,
+			expected: "*Commits*\n\n• <https://github.com/o/r/commit/1111111aaaa|1111111> fix: don't panic on <nil> (#5) (<https://github.com/o/r/pull/5|#5>) by alice\n• <https://github.com/o/r/commit/2222222bbbb|2222222> Update README.md by bob",
+		},
+		"Plain text template": {
+			template: domain.CommitListPlainTemplate,
+			expected: "alice (1):\n  1111111 fix: don't panic on <nil> (#5)\n\nbob (1):\n  2222222 Update README.md",
+		},
+		"Template of the configured directory": {
+			template: "short",
+			expected: "1111111 2222222 ",
+		},
+		"Template of the configured directory overriding the changelog one": {
+			template: domain.CommitListChangelogTemplate,
+			expected: "alice bob ",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := srv.PrintCommitList(commitList, tc.template)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as printout, but got '%v'", tc.expected, actual)
+			}
+			if err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+		})
+	}
+
+	t.Run("Use a template that does not exist - expecting an error", func(t *testing.T) {
+		_, err := srv.PrintCommitList(commitList, "unknown")
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+
+	t.Run("Use the path of a template file - expecting an error", func(t *testing.T) {
+		_, err := srv.PrintCommitList(commitList, filepath.Join(dir, "short.tmpl"))
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetFileContent(t *testing.T) {
+	client := &mock.Client{}
+	client.On("GetRepositoryContent", "token", "o", "r", "CHANGELOG.md", "master").Return(domain.RepositoryContent{Sha: "abc", Content: base64.StdEncoding.EncodeToString([]byte("# Changelog\n"))}, nil)
+	client.On("GetRepositoryContent", "token", "o", "r", "MISSING.md", "master").Return(domain.RepositoryContent{}, nil)
+
+	srv, _ := repository.NewService(client, config.Config{})
+
+	testCases := map[string]struct {
+		path            string
+		expectedContent string
+		expectedSha     string
+	}{
+		"Existing file":     {path: "CHANGELOG.md", expectedContent: "# Changelog\n", expectedSha: "abc"},
+		"File not existing": {path: "MISSING.md", expectedContent: "", expectedSha: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualContent, actualSha, err := srv.GetFileContent("token", "o", "r", tc.path, "master")
+			if actualContent != tc.expectedContent {
+				t.Errorf("Expected to get '%v' as content, but got '%v'", tc.expectedContent, actualContent)
+			}
+			if actualSha != tc.expectedSha {
+				t.Errorf("Expected to get '%v' as sha, but got '%v'", tc.expectedSha, actualSha)
+			}
+			if err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+		})
+	}
+}
+
+func TestCreatePullRequestWithFile(t *testing.T) {
+	t.Run("Create the branch, commit the file and open the pull request", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetBranchReference", "token", "o", "r", "master").Return(domain.GitReference{Ref: "refs/heads/master", Object: domain.GitObject{Sha: "base"}}, nil)
+		client.On("CreateReference", "token", "o", "r", "changelog/v1.0.0", "base").Return(domain.GitReference{Ref: "refs/heads/changelog/v1.0.0"}, nil)
+		client.On("UpdateRepositoryContent", "token", "o", "r", "CHANGELOG.md", "changelog/v1.0.0", "message", "content", "abc").Return(domain.RepositoryContentUpdate{Commit: domain.Commit{Sha: "new"}}, nil)
+		client.On("CreatePullRequest", "token", "o", "r", "title", "changelog/v1.0.0", "master", "body").Return(domain.PullRequest{Number: 7}, nil)
+
+		srv, _ := repository.NewService(client, config.Config{})
+
+		actual, err := srv.CreatePullRequestWithFile("token", "o", "r", "master", "changelog/v1.0.0", "CHANGELOG.md", "content", "abc", "message", "title", "body")
+
+		expected := domain.PullRequest{Number: 7}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as pull request, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Base branch does not exist - expecting an error", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetBranchReference", "token", "o", "r", "master").Return(domain.GitReference{}, nil)
+
+		srv, _ := repository.NewService(client, config.Config{})
+
+		_, err := srv.CreatePullRequestWithFile("token", "o", "r", "master", "changelog/v1.0.0", "CHANGELOG.md", "content", "", "message", "title", "body")
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetCommitList(t *testing.T) {
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	firstPage := make([]domain.Commit, 100)
+	for idx := range firstPage {
+		firstPage[idx] = domain.Commit{Sha: "a", Parents: []domain.CommitParent{{Sha: "p"}}}
+	}
+	firstPage[99] = domain.Commit{Sha: "merge", Parents: []domain.CommitParent{{Sha: "p"}, {Sha: "q"}}}
+
+	t.Run("Commits of all the pages without the merge commits", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetCommitList", "token", "o", "r", "main", since, until, 100, 1).Return(firstPage, nil)
+		client.On("GetCommitList", "token", "o", "r", "main", since, until, 100, 2).Return([]domain.Commit{{Sha: "b"}}, nil)
+
+		srv, _ := repository.NewService(client, config.Config{})
+
+		actual, err := srv.GetCommitList("token", "o", "r", "main", since, until)
+
+		expected := append(append([]domain.Commit{}, firstPage[:99]...), domain.Commit{Sha: "b"})
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' commits ending with '%v', but got '%v' commits", len(expected), expected[len(expected)-1], len(actual))
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Failure to retrieve a page - expecting an error", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetCommitList", "token", "o", "r", "main", since, until, 100, 1).Return([]domain.Commit{}, errors.New("failure"))
+
+		srv, _ := repository.NewService(client, config.Config{})
+
+		_, err := srv.GetCommitList("token", "o", "r", "main", since, until)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetCommitsWithFiles(t *testing.T) {
+	diff := domain.CompareTagsResponse{
+		Commits: []domain.Commit{
+			{Sha: "a", Author: domain.User{Username: "alice"}},
+			{Sha: "b", Author: domain.User{Username: "bot"}},
+		},
+	}
+	details := domain.Commit{
+		Sha:    "a",
+		Author: domain.User{Username: "alice"},
+		Files:  []domain.CommitFile{{Filename: "main.go"}, {Filename: "go.sum"}},
+	}
+
+	skipCommit := func(commit domain.Commit) bool {
+		return commit.Author.Username == "bot"
+	}
+	isExcluded := func(filename string) bool {
+		return filename == "go.sum"
+	}
+
+	t.Run("Commits between tags without the skipped commits and excluded files", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetDiffBetweenTags", "token", "o", "r", "v1.0.0", "v1.1.0").Return(diff, nil)
+		client.On("GetCommitDetails", "token", "o", "r", "a").Return(details, nil)
+
+		srv, _ := repository.NewService(client, config.Config{})
+
+		actual, err := srv.GetCommitsWithFiles("token", "o", "r", "main", "v1.0.0", "v1.1.0", time.Time{}, time.Time{}, skipCommit, isExcluded)
+
+		expected := []domain.Commit{
+			{Sha: "a", Author: domain.User{Username: "alice"}, Files: []domain.CommitFile{{Filename: "main.go"}}},
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as commits, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Failure to retrieve the details of a commit - expecting an error", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetDiffBetweenTags", "token", "o", "r", "v1.0.0", "v1.1.0").Return(diff, nil)
+		client.On("GetCommitDetails", "token", "o", "r", "a").Return(domain.Commit{}, errors.New("failure"))
+
+		srv, _ := repository.NewService(client, config.Config{})
+
+		_, err := srv.GetCommitsWithFiles("token", "o", "r", "main", "v1.0.0", "v1.1.0", time.Time{}, time.Time{}, nil, nil)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetReleaseByTag(t *testing.T) {
+	firstPage := make([]domain.Release, 100)
+	for idx := range firstPage {
+		firstPage[idx] = domain.Release{ID: idx + 1, TagName: "v0.0.0"}
+	}
+
+	client := &mock.Client{}
+	client.On("GetReleaseList", "token", "o", "r", 100, 1).Return(firstPage, nil)
+	client.On("GetReleaseList", "token", "o", "r", 100, 2).Return([]domain.Release{{ID: 200, TagName: "v1.0.0", Draft: true}}, nil)
+
+	srv, _ := repository.NewService(client, config.Config{})
+
+	t.Run("Release of the second page", func(t *testing.T) {
+		actual, err := srv.GetReleaseByTag("token", "o", "r", "v1.0.0")
+
+		expected := domain.Release{ID: 200, TagName: "v1.0.0", Draft: true}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as release, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Release that does not exist - expecting an error", func(t *testing.T) {
+		_, err := srv.GetReleaseByTag("token", "o", "r", "v2.0.0")
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestUpdateRelease(t *testing.T) {
+	draft := false
+	releaseUpdate := domain.ReleaseUpdate{Draft: &draft}
+
+	client := &mock.Client{}
+	client.On("UpdateRelease", "token", "o", "r", 1, releaseUpdate).Return(domain.Release{ID: 1, TagName: "v1.0.0"}, nil)
+	client.On("UpdateRelease", "token", "o", "r", 2, releaseUpdate).Return(domain.Release{}, nil)
+
+	srv, _ := repository.NewService(client, config.Config{})
+
+	t.Run("Update an existing release", func(t *testing.T) {
+		actual, err := srv.UpdateRelease("token", "o", "r", 1, releaseUpdate)
+
+		expected := domain.Release{ID: 1, TagName: "v1.0.0"}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as release, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Update a release that does not exist - expecting an error", func(t *testing.T) {
+		_, err := srv.UpdateRelease("token", "o", "r", 2, releaseUpdate)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestUploadReleaseAsset(t *testing.T) {
+	release := domain.Release{ID: 1, Assets: []domain.ReleaseAsset{{ID: 10, Name: "app.tar.gz"}}}
+
+	testCases := map[string]struct {
+		name             string
+		replace          bool
+		expectedAsset    domain.ReleaseAsset
+		expectedUploaded bool
+	}{
+		"New asset": {
+			name:             "SHA256SUMS",
+			expectedAsset:    domain.ReleaseAsset{ID: 20, Name: "SHA256SUMS"},
+			expectedUploaded: true,
+		},
+		"Existing asset is skipped": {
+			name:             "app.tar.gz",
+			expectedAsset:    domain.ReleaseAsset{ID: 10, Name: "app.tar.gz"},
+			expectedUploaded: false,
+		},
+		"Existing asset is replaced": {
+			name:             "app.tar.gz",
+			replace:          true,
+			expectedAsset:    domain.ReleaseAsset{ID: 20, Name: "app.tar.gz"},
+			expectedUploaded: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mock.Client{}
+			client.On("DeleteReleaseAsset", "token", "o", "r", 10).Return(nil)
+			client.On("UploadReleaseAsset", "token", "o", "r", 1, tc.name, testifyMock.Anything, testifyMock.Anything, int64(4)).Return(domain.ReleaseAsset{ID: 20, Name: tc.name}, nil)
+
+			srv, _ := repository.NewService(client, config.Config{})
+
+			actualAsset, actualUploaded, err := srv.UploadReleaseAsset("token", "o", "r", release, tc.name, strings.NewReader("data"), 4, tc.replace)
+			if !reflect.DeepEqual(tc.expectedAsset, actualAsset) {
+				t.Errorf("Expected to get '%v' as asset, but got '%v'", tc.expectedAsset, actualAsset)
+			}
+			if actualUploaded != tc.expectedUploaded {
+				t.Errorf("Expected to get '%v' as uploaded, but got '%v'", tc.expectedUploaded, actualUploaded)
+			}
+			if err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+
+			if tc.replace {
+				client.AssertCalled(t, "DeleteReleaseAsset", "token", "o", "r", 10)
+			} else {
+				client.AssertNotCalled(t, "DeleteReleaseAsset", "token", "o", "r", 10)
+			}
+		})
+	}
+}
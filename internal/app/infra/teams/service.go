@@ -0,0 +1,138 @@
+package teams
+
+import (
+	"fmt"
+
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const (
+	defaultPageSize = 100
+)
+
+type resource interface {
+	GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error)
+}
+
+// Service describes the teams service.
+type Service struct {
+	resource resource
+	teams    map[string]config.Team
+}
+
+// NewService creates and returns a service instance.
+func NewService(resource resource, cfg config.Config) *Service {
+	return &Service{
+		resource: resource,
+		teams:    cfg.Teams,
+	}
+}
+
+// GetTeamMembers returns the usernames of the members of a team as defined in the configuration. In case the team
+// refers to an organization team, the members of it are retrieved from github as well.
+func (s *Service) GetTeamMembers(authToken, teamName string) ([]string, error) {
+	team, ok := s.teams[teamName]
+	if !ok {
+		return []string{}, fmt.Errorf("team %q is not defined in the configuration", teamName)
+	}
+
+	distinctMembers := make(map[string]struct{})
+	members := []string{}
+	for _, m := range team.Members {
+		if _, exists := distinctMembers[m]; !exists {
+			distinctMembers[m] = struct{}{}
+			members = append(members, m)
+		}
+	}
+
+	if team.Organization == "" || team.Slug == "" {
+		return members, nil
+	}
+
+	currentPage := 1
+	for {
+		teamMembers, err := s.resource.GetTeamMembers(authToken, team.Organization, team.Slug, defaultPageSize, currentPage)
+		if err != nil {
+			return []string{}, err
+		}
+
+		for _, u := range teamMembers {
+			if _, exists := distinctMembers[u.Username]; !exists {
+				distinctMembers[u.Username] = struct{}{}
+				members = append(members, u.Username)
+			}
+		}
+
+		if len(teamMembers) < defaultPageSize {
+			break
+		}
+
+		currentPage++
+	}
+
+	return members, nil
+}
+
+// GetFilterMembers validates the team role and returns the members of the team to filter the pull requests with. In
+// case no team is provided, no members are returned, so that the pull requests are not filtered at all.
+func (s *Service) GetFilterMembers(authToken, teamName, teamRole string) ([]string, error) {
+	if teamRole != domain.TeamRoleAuthor && teamRole != domain.TeamRoleReviewer {
+		return nil, fmt.Errorf("invalid team role %q, expected one of : %v, %v", teamRole, domain.TeamRoleAuthor, domain.TeamRoleReviewer)
+	}
+
+	if teamName == "" {
+		return nil, nil
+	}
+
+	return s.GetTeamMembers(authToken, teamName)
+}
+
+// FilterPullRequests keeps only the pull requests that belong to the provided team members. In case no members are
+// provided, the pull requests are returned as they are.
+func (s *Service) FilterPullRequests(pullRequests []domain.PullRequest, members []string, teamRole string) []domain.PullRequest {
+	if len(members) == 0 {
+		return pullRequests
+	}
+
+	filteredPullRequests := []domain.PullRequest{}
+	for _, pr := range pullRequests {
+		if s.IsTeamPullRequest(pr, members, teamRole) {
+			filteredPullRequests = append(filteredPullRequests, pr)
+		}
+	}
+
+	return filteredPullRequests
+}
+
+// IsTeamPullRequest checks if a pull request has been created by one of the team members, or in case of the reviewer
+// role, if any of the team members has been requested to review or has reviewed the pull request.
+func (s *Service) IsTeamPullRequest(pullRequest domain.PullRequest, members []string, teamRole string) bool {
+	if len(members) == 0 {
+		return true
+	}
+
+	teamMembers := make(map[string]struct{})
+	for _, m := range members {
+		teamMembers[m] = struct{}{}
+	}
+
+	if teamRole != domain.TeamRoleReviewer {
+		_, isMember := teamMembers[pullRequest.Creator.Username]
+		return isMember
+	}
+
+	for _, r := range pullRequest.Reviewers {
+		if _, isMember := teamMembers[r.Username]; isMember {
+			return true
+		}
+	}
+
+	for reviewer := range pullRequest.ReviewStates {
+		if _, isMember := teamMembers[reviewer]; isMember {
+			return true
+		}
+	}
+
+	return false
+}
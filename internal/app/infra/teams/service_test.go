@@ -0,0 +1,180 @@
+package teams_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/app/infra/teams"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/test/mock"
+)
+
+func TestGetTeamMembers(t *testing.T) {
+	var cfg config.Config
+	cfg.Teams = map[string]config.Team{
+		"static": {Members: []string{"alice", "bob", "alice"}},
+		"github": {Members: []string{"alice"}, Organization: "org", Slug: "core"},
+	}
+
+	t.Run("Retrieve the members that are defined in the configuration", func(t *testing.T) {
+		srv := teams.NewService(&mock.Client{}, cfg)
+
+		actualMembers, actualError := srv.GetTeamMembers("token", "static")
+
+		expectedMembers := []string{"alice", "bob"}
+		if !reflect.DeepEqual(expectedMembers, actualMembers) {
+			t.Errorf("Expected to get '%v' as members, but got '%v'", expectedMembers, actualMembers)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Combine the configured members with the ones of the organization team", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetTeamMembers", "token", "org", "core", 100, 1).Return([]domain.User{{Username: "alice"}, {Username: "carol"}}, nil)
+		srv := teams.NewService(client, cfg)
+
+		actualMembers, actualError := srv.GetTeamMembers("token", "github")
+
+		expectedMembers := []string{"alice", "carol"}
+		if !reflect.DeepEqual(expectedMembers, actualMembers) {
+			t.Errorf("Expected to get '%v' as members, but got '%v'", expectedMembers, actualMembers)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Fail to retrieve the members of the organization team - expecting an error", func(t *testing.T) {
+		expectedError := errors.New("not found")
+		client := &mock.Client{}
+		client.On("GetTeamMembers", "token", "org", "core", 100, 1).Return([]domain.User{}, expectedError)
+		srv := teams.NewService(client, cfg)
+
+		_, actualError := srv.GetTeamMembers("token", "github")
+
+		if !reflect.DeepEqual(expectedError, actualError) {
+			t.Errorf("Expected to get '%v' as error, but got '%v'", expectedError, actualError)
+		}
+	})
+
+	t.Run("Request a team that is not defined - expecting an error", func(t *testing.T) {
+		srv := teams.NewService(&mock.Client{}, cfg)
+
+		_, actualError := srv.GetTeamMembers("token", "unknown")
+
+		if actualError == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetFilterMembers(t *testing.T) {
+	var cfg config.Config
+	cfg.Teams = map[string]config.Team{
+		"static": {Members: []string{"alice", "bob"}},
+	}
+
+	srv := teams.NewService(&mock.Client{}, cfg)
+
+	type expected struct {
+		members []string
+		isError bool
+	}
+
+	testCases := map[string]struct {
+		teamName string
+		teamRole string
+		expected expected
+	}{
+		"Retrieve the members of the team for the author role": {
+			teamName: "static",
+			teamRole: domain.TeamRoleAuthor,
+			expected: expected{members: []string{"alice", "bob"}},
+		},
+		"Retrieve the members of the team for the reviewer role": {
+			teamName: "static",
+			teamRole: domain.TeamRoleReviewer,
+			expected: expected{members: []string{"alice", "bob"}},
+		},
+		"No team provided": {
+			teamName: "",
+			teamRole: domain.TeamRoleAuthor,
+			expected: expected{members: nil},
+		},
+		"Invalid team role - expecting an error": {
+			teamName: "static",
+			teamRole: "owner",
+			expected: expected{members: nil, isError: true},
+		},
+		"Invalid team role without a team - expecting an error": {
+			teamName: "",
+			teamRole: "",
+			expected: expected{members: nil, isError: true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualMembers, actualError := srv.GetFilterMembers("token", tc.teamName, tc.teamRole)
+
+			if !reflect.DeepEqual(tc.expected.members, actualMembers) {
+				t.Errorf("Expected to get '%v' as members, but got '%v'", tc.expected.members, actualMembers)
+			}
+			if tc.expected.isError != (actualError != nil) {
+				t.Errorf("Expected to get an error '%v', but got '%v'", tc.expected.isError, actualError)
+			}
+		})
+	}
+}
+
+func TestFilterPullRequests(t *testing.T) {
+	var cfg config.Config
+	srv := teams.NewService(&mock.Client{}, cfg)
+
+	pullRequests := []domain.PullRequest{
+		{Number: 1, Creator: domain.User{Username: "alice"}},
+		{Number: 2, Creator: domain.User{Username: "dave"}, Reviewers: []domain.User{{Username: "bob"}}},
+		{Number: 3, Creator: domain.User{Username: "dave"}, ReviewStates: map[string]string{"alice": "APPROVED"}},
+		{Number: 4, Creator: domain.User{Username: "erin"}},
+	}
+
+	type input struct {
+		members  []string
+		teamRole string
+	}
+
+	testCases := map[string]struct {
+		input    input
+		expected []int
+	}{
+		"No team members provided - expecting all pull requests": {
+			input{nil, domain.TeamRoleAuthor},
+			[]int{1, 2, 3, 4},
+		},
+		"Filter pull requests created by the team members": {
+			input{[]string{"alice", "bob"}, domain.TeamRoleAuthor},
+			[]int{1},
+		},
+		"Filter pull requests reviewed by the team members": {
+			input{[]string{"alice", "bob"}, domain.TeamRoleReviewer},
+			[]int{2, 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var actualNumbers []int
+			for _, pr := range srv.FilterPullRequests(pullRequests, tc.input.members, tc.input.teamRole) {
+				actualNumbers = append(actualNumbers, pr.Number)
+			}
+
+			if !reflect.DeepEqual(tc.expected, actualNumbers) {
+				t.Errorf("Expected to get '%v' as pull requests, but got '%v'", tc.expected, actualNumbers)
+			}
+		})
+	}
+}
@@ -1,22 +1,37 @@
 package userrepos
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/eujoy/gitpr/internal/config"
 	"github.com/eujoy/gitpr/internal/domain"
 )
 
+const (
+	defaultPageSize = 100
+)
+
 type resource interface {
+	GetAuthenticatedUser(authToken string) (domain.User, error)
+	GetOrganizationRepos(authToken, org string, pageSize, pageNumber int) ([]domain.Repository, error)
+	GetTeamRepos(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.Repository, error)
 	GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error)
 }
 
 // Service describes the user repositories service.
 type Service struct {
 	resource resource
+	teams    map[string]config.Team
 }
 
 // NewService creates and returns a service instance.
-func NewService(resource resource) *Service {
+func NewService(resource resource, cfg config.Config) *Service {
 	return &Service{
 		resource: resource,
+		teams:    cfg.Teams,
 	}
 }
 
@@ -25,3 +40,159 @@ func (s *Service) GetUserRepos(authToken string, pageSize int, pageNumber int) (
 	userRepos, err := s.resource.GetUserRepos(authToken, pageSize, pageNumber)
 	return userRepos, err
 }
+
+// GetAuthenticatedUser retrieves the details of the user that the token belongs to.
+func (s *Service) GetAuthenticatedUser(authToken string) (domain.User, error) {
+	user, err := s.resource.GetAuthenticatedUser(authToken)
+	return user, err
+}
+
+// SelectRepositories retrieves the repositories that match the criteria of the selector. The repositories are
+// retrieved from the organization team that the team of the configuration refers to, or else from the organization or
+// the authenticated user. A team that does not refer to an organization team cannot be used to select repositories.
+func (s *Service) SelectRepositories(authToken string, selector domain.RepositorySelector) ([]domain.Repository, error) {
+	var org, teamSlug string
+	if selector.Team != "" {
+		team, ok := s.teams[selector.Team]
+		if !ok {
+			return []domain.Repository{}, fmt.Errorf("team %q is not defined in the configuration", selector.Team)
+		}
+
+		org, teamSlug = team.Organization, team.Slug
+		if org == "" || teamSlug == "" {
+			return []domain.Repository{}, fmt.Errorf("team %q has no organization and slug in the configuration to select its repositories", selector.Team)
+		}
+
+		if selector.Organization != "" && !strings.EqualFold(selector.Organization, org) {
+			return []domain.Repository{}, fmt.Errorf("team %q belongs to organization %q instead of %q", selector.Team, org, selector.Organization)
+		}
+	}
+
+	var repositories []domain.Repository
+	currentPage := 1
+	for {
+		var repos []domain.Repository
+		var err error
+
+		switch {
+		case teamSlug != "":
+			repos, err = s.resource.GetTeamRepos(authToken, org, teamSlug, defaultPageSize, currentPage)
+		case selector.Organization != "":
+			repos, err = s.resource.GetOrganizationRepos(authToken, selector.Organization, defaultPageSize, currentPage)
+		default:
+			var userRepos domain.UserReposResponse
+			userRepos, err = s.resource.GetUserRepos(authToken, defaultPageSize, currentPage)
+			repos = userRepos.Repositories
+		}
+
+		if err != nil {
+			return []domain.Repository{}, err
+		}
+
+		repositories = append(repositories, repos...)
+
+		if len(repos) < defaultPageSize {
+			break
+		}
+
+		currentPage++
+	}
+
+	// The repositories of an organization team are not filtered by the organization, since it has already been checked
+	// against the organization of the team.
+	if teamSlug != "" {
+		selector.Organization = ""
+	}
+
+	return s.FilterRepositories(repositories, selector)
+}
+
+// GetRepositoryNames returns the full names of the provided repositories or, in case none are provided, the full names
+// of the repositories that match the criteria of the selector. The repositories that are provided without an owner are
+// considered to belong to the provided owner.
+func (s *Service) GetRepositoryNames(authToken, owner string, repositories []string, selector domain.RepositorySelector) ([]string, error) {
+	if len(repositories) == 0 {
+		if selector.IsEmpty() {
+			return []string{}, errors.New("either the repositories or the criteria to select them need to be provided")
+		}
+
+		selectedRepositories, err := s.SelectRepositories(authToken, selector)
+		if err != nil {
+			return []string{}, err
+		}
+
+		for _, r := range selectedRepositories {
+			repositories = append(repositories, r.FullName)
+		}
+	}
+
+	repoNames := []string{}
+	for _, r := range repositories {
+		if owner != "" && !strings.Contains(r, "/") {
+			r = fmt.Sprintf("%v/%v", owner, r)
+		}
+
+		if len(strings.Split(r, "/")) != 2 {
+			return []string{}, fmt.Errorf("invalid repository %q, expected format 'owner/repository'", r)
+		}
+
+		repoNames = append(repoNames, r)
+	}
+
+	return repoNames, nil
+}
+
+// FilterRepositories keeps only the repositories that match the criteria of the selector, apart from the team one. A
+// repository matches the topics and the languages when it has any of them and the name regex is matched against the
+// name of the repository.
+func (s *Service) FilterRepositories(repositories []domain.Repository, selector domain.RepositorySelector) ([]domain.Repository, error) {
+	var nameRegex *regexp.Regexp
+	if selector.NameRegex != "" {
+		regex, err := regexp.Compile(selector.NameRegex)
+		if err != nil {
+			return []domain.Repository{}, fmt.Errorf("invalid name regex %q : %v", selector.NameRegex, err)
+		}
+
+		nameRegex = regex
+	}
+
+	filteredRepositories := []domain.Repository{}
+	for _, r := range repositories {
+		if selector.Organization != "" && !strings.EqualFold(strings.Split(r.FullName, "/")[0], selector.Organization) {
+			continue
+		}
+
+		if (selector.ExcludeArchived && r.Archived) || (selector.ExcludeForks && r.Fork) {
+			continue
+		}
+
+		if len(selector.Topics) > 0 && !containsAny(r.Topics, selector.Topics) {
+			continue
+		}
+
+		if len(selector.Languages) > 0 && !containsAny([]string{r.Language}, selector.Languages) {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(r.Name) {
+			continue
+		}
+
+		filteredRepositories = append(filteredRepositories, r)
+	}
+
+	return filteredRepositories, nil
+}
+
+// containsAny checks whether any of the values is included in the list, ignoring the case.
+func containsAny(list []string, values []string) bool {
+	for _, l := range list {
+		for _, v := range values {
+			if strings.EqualFold(l, v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
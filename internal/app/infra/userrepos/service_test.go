@@ -0,0 +1,237 @@
+package userrepos_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/app/infra/userrepos"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/test/mock"
+)
+
+func TestSelectRepositories(t *testing.T) {
+	var cfg config.Config
+	cfg.Teams = map[string]config.Team{
+		"static": {Members: []string{"alice"}},
+		"github": {Organization: "org", Slug: "core"},
+	}
+
+	repositories := []domain.Repository{
+		{Name: "api", FullName: "org/api"},
+		{Name: "web", FullName: "org/web", Archived: true},
+	}
+
+	t.Run("Retrieve the repositories of the organization team", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetTeamRepos", "token", "org", "core", 100, 1).Return(repositories, nil)
+		srv := userrepos.NewService(client, cfg)
+
+		actualRepositories, actualError := srv.SelectRepositories("token", domain.RepositorySelector{Team: "github", ExcludeArchived: true})
+
+		expectedRepositories := []domain.Repository{{Name: "api", FullName: "org/api"}}
+		if !reflect.DeepEqual(expectedRepositories, actualRepositories) {
+			t.Errorf("Expected to get '%v' as repositories, but got '%v'", expectedRepositories, actualRepositories)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Request a team without an organization team - expecting an error", func(t *testing.T) {
+		srv := userrepos.NewService(&mock.Client{}, cfg)
+
+		_, actualError := srv.SelectRepositories("token", domain.RepositorySelector{Organization: "org", Team: "static"})
+
+		if actualError == nil || !strings.Contains(actualError.Error(), `"static"`) {
+			t.Errorf("Expected to get an error that names the team, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Retrieve the repositories of the authenticated user", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetUserRepos", "token", 100, 1).Return(domain.UserReposResponse{Repositories: repositories}, nil)
+		srv := userrepos.NewService(client, cfg)
+
+		actualRepositories, actualError := srv.SelectRepositories("token", domain.RepositorySelector{})
+
+		if !reflect.DeepEqual(repositories, actualRepositories) {
+			t.Errorf("Expected to get '%v' as repositories, but got '%v'", repositories, actualRepositories)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Fail to retrieve the repositories of the organization - expecting an error", func(t *testing.T) {
+		expectedError := errors.New("not found")
+		client := &mock.Client{}
+		client.On("GetOrganizationRepos", "token", "org", 100, 1).Return([]domain.Repository{}, expectedError)
+		srv := userrepos.NewService(client, cfg)
+
+		_, actualError := srv.SelectRepositories("token", domain.RepositorySelector{Organization: "org"})
+
+		if !reflect.DeepEqual(expectedError, actualError) {
+			t.Errorf("Expected to get '%v' as error, but got '%v'", expectedError, actualError)
+		}
+	})
+
+	t.Run("Retrieve the repositories of the organization team along with the same organization", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetTeamRepos", "token", "org", "core", 100, 1).Return(repositories, nil)
+		srv := userrepos.NewService(client, cfg)
+
+		actualRepositories, actualError := srv.SelectRepositories("token", domain.RepositorySelector{Organization: "ORG", Team: "github"})
+
+		if !reflect.DeepEqual(repositories, actualRepositories) {
+			t.Errorf("Expected to get '%v' as repositories, but got '%v'", repositories, actualRepositories)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Request an organization team along with another organization - expecting an error", func(t *testing.T) {
+		srv := userrepos.NewService(&mock.Client{}, cfg)
+
+		_, actualError := srv.SelectRepositories("token", domain.RepositorySelector{Organization: "other", Team: "github"})
+
+		if actualError == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+
+	t.Run("Request a team that is not defined - expecting an error", func(t *testing.T) {
+		srv := userrepos.NewService(&mock.Client{}, cfg)
+
+		_, actualError := srv.SelectRepositories("token", domain.RepositorySelector{Team: "unknown"})
+
+		if actualError == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestFilterRepositories(t *testing.T) {
+	repositories := []domain.Repository{
+		{Name: "api-svc", FullName: "org/api-svc", Language: "Go", Topics: []string{"backend", "payments"}},
+		{Name: "web", FullName: "org/web", Language: "TypeScript", Topics: []string{"frontend"}},
+		{Name: "old-svc", FullName: "org/old-svc", Language: "Go", Archived: true},
+		{Name: "lib", FullName: "other/lib", Language: "go", Fork: true},
+	}
+
+	testCases := map[string]struct {
+		selector domain.RepositorySelector
+		expected []string
+	}{
+		"Empty selector": {
+			selector: domain.RepositorySelector{},
+			expected: []string{"org/api-svc", "org/web", "org/old-svc", "other/lib"},
+		},
+		"Filter by organization": {
+			selector: domain.RepositorySelector{Organization: "other"},
+			expected: []string{"other/lib"},
+		},
+		"Filter by topics": {
+			selector: domain.RepositorySelector{Topics: []string{"Frontend", "payments"}},
+			expected: []string{"org/api-svc", "org/web"},
+		},
+		"Filter by languages": {
+			selector: domain.RepositorySelector{Languages: []string{"go"}},
+			expected: []string{"org/api-svc", "org/old-svc", "other/lib"},
+		},
+		"Filter by name regex": {
+			selector: domain.RepositorySelector{NameRegex: "-svc$"},
+			expected: []string{"org/api-svc", "org/old-svc"},
+		},
+		"Exclude archived repositories and forks": {
+			selector: domain.RepositorySelector{ExcludeArchived: true, ExcludeForks: true},
+			expected: []string{"org/api-svc", "org/web"},
+		},
+		"No matching repositories": {
+			selector: domain.RepositorySelector{Languages: []string{"rust"}},
+			expected: []string{},
+		},
+	}
+
+	srv := userrepos.NewService(nil, config.Config{})
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filteredRepositories, err := srv.FilterRepositories(repositories, tc.selector)
+			if err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+
+			actual := []string{}
+			for _, r := range filteredRepositories {
+				actual = append(actual, r.FullName)
+			}
+
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%v' as repositories, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+
+	t.Run("Provide an invalid name regex - expecting an error", func(t *testing.T) {
+		_, err := srv.FilterRepositories(repositories, domain.RepositorySelector{NameRegex: "["})
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetRepositoryNames(t *testing.T) {
+	t.Run("Use the provided repositories along with the owner", func(t *testing.T) {
+		srv := userrepos.NewService(&mock.Client{}, config.Config{})
+
+		actualNames, actualError := srv.GetRepositoryNames("token", "org", []string{"api", "org/web"}, domain.RepositorySelector{Organization: "other"})
+
+		expectedNames := []string{"org/api", "org/web"}
+		if !reflect.DeepEqual(expectedNames, actualNames) {
+			t.Errorf("Expected to get '%v' as repository names, but got '%v'", expectedNames, actualNames)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Use the repositories that match the selector", func(t *testing.T) {
+		client := &mock.Client{}
+		client.On("GetOrganizationRepos", "token", "org", 100, 1).Return([]domain.Repository{{Name: "api", FullName: "org/api"}, {Name: "web", FullName: "org/web", Fork: true}}, nil)
+		srv := userrepos.NewService(client, config.Config{})
+
+		actualNames, actualError := srv.GetRepositoryNames("token", "", []string{}, domain.RepositorySelector{Organization: "org", ExcludeForks: true})
+
+		expectedNames := []string{"org/api"}
+		if !reflect.DeepEqual(expectedNames, actualNames) {
+			t.Errorf("Expected to get '%v' as repository names, but got '%v'", expectedNames, actualNames)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Provide neither repositories nor a selector - expecting an error", func(t *testing.T) {
+		srv := userrepos.NewService(&mock.Client{}, config.Config{})
+
+		_, actualError := srv.GetRepositoryNames("token", "", []string{}, domain.RepositorySelector{})
+
+		if actualError == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+
+	t.Run("Provide an invalid repository - expecting an error", func(t *testing.T) {
+		srv := userrepos.NewService(&mock.Client{}, config.Config{})
+
+		_, actualError := srv.GetRepositoryNames("token", "", []string{"api"}, domain.RepositorySelector{})
+
+		if actualError == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
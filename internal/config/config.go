@@ -8,6 +8,15 @@ import (
     "gopkg.in/yaml.v2"
 )
 
+// defaultAlertExitCode is the exit code of the alerts in case none is configured, so that they never exit successfully.
+const defaultAlertExitCode = 1
+
+type aging struct {
+    AgeBucketsInDays []int `yaml:"age_buckets_in_days"`
+    StaleAfterDays   int   `yaml:"stale_after_days"`
+    AlertExitCode    int   `yaml:"alert_exit_code"`
+}
+
 type application struct {
     Author  string `yaml:"author"`
     Name    string `yaml:"name"`
@@ -15,20 +24,48 @@ type application struct {
     Version string `yaml:"version"`
 }
 
+type categorisation struct {
+    DefaultCategory string     `yaml:"default_category"`
+    Categories      []Category `yaml:"categories"`
+}
+
 type clients struct {
     Github github `yaml:"github"`
 }
 
 type endpoints struct {
+    DeleteRelease                string `yaml:"delete_release"`
+    DeleteReleaseAsset           string `yaml:"delete_release_asset"`
+    GetAuthenticatedUser         string `yaml:"get_authenticated_user"`
+    GetBranchReference           string `yaml:"get_branch_reference"`
     GetCommitDetails             string `yaml:"get_commit_details"`
+    GetCommitList                string `yaml:"get_commit_list"`
+    GetCommitPullRequests        string `yaml:"get_commit_pull_requests"`
     GetDiffBetweenTags           string `yaml:"get_diff_between_tags"`
+    GetFileCommitList            string `yaml:"get_file_commit_list"`
+    GetIssueComments             string `yaml:"get_issue_comments"`
+    GetIssueTimeline             string `yaml:"get_issue_timeline"`
+    GetOrganizationRepos         string `yaml:"get_organization_repos"`
     GetPullRequestCommits        string `yaml:"get_pull_request_commits"`
     GetPullRequestDetails        string `yaml:"get_pull_request_details"`
+    GetPullRequestFiles          string `yaml:"get_pull_request_files"`
     GetReleaseList               string `yaml:"get_release_list"`
+    GetRepositoryContent         string `yaml:"get_repository_content"`
+    GetRepositoryTree            string `yaml:"get_repository_tree"`
     GetReviewStatusOfPullRequest string `yaml:"get_review_status_of_pull_request"`
+    GetTagList                   string `yaml:"get_tag_list"`
+    GetTeamMembers               string `yaml:"get_team_members"`
+    GetTeamRepos                 string `yaml:"get_team_repos"`
     GetUserRepos                 string `yaml:"get_user_repos"`
     GetUserPullRequestsForRepo   string `yaml:"get_user_pull_requests_for_repo"`
+    PatchUpdateRelease           string `yaml:"patch_update_release"`
+    PostCreatePullRequest        string `yaml:"post_create_pull_request"`
+    PostCreateReference          string `yaml:"post_create_reference"`
     PostCreateRelease            string `yaml:"post_create_release"`
+    PostRequestReviewers         string `yaml:"post_request_reviewers"`
+    PostUploadReleaseAsset       string `yaml:"post_upload_release_asset"`
+    PutRepositoryContent         string `yaml:"put_repository_content"`
+    SearchIssues                 string `yaml:"search_issues"`
     WorkflowRuns                 string `yaml:"get_workflow_details"`
     WorkflowsOfRepository        string `yaml:"get_workflows_of_repository"`
     WorkflowTiming               string `yaml:"get_workflow_execution_timing"`
@@ -44,6 +81,11 @@ type token struct {
     DefaultValue  string `yaml:"default_value"`
 }
 
+type automation struct {
+    IgnoredUsers   []string `yaml:"ignored_users"`
+    ExcludedLabels []string `yaml:"excluded_labels"`
+}
+
 type billing struct {
     Linux   float32 `yaml:"linux"`
     MacOS   float32 `yaml:"macOS"`
@@ -52,6 +94,7 @@ type billing struct {
 
 type github struct {
     ApiUrl    string        `yaml:"api_url"`
+    UploadUrl string        `yaml:"upload_url"`
     Headers   headers       `yaml:"headers"`
     Endpoints endpoints     `yaml:"endpoints"`
     Timeout   time.Duration `yaml:"timeout"`
@@ -59,12 +102,49 @@ type github struct {
     Billing   billing       `yaml:"billing"`
 }
 
+type hotspots struct {
+    Depth int `yaml:"depth"`
+    Top   int `yaml:"top"`
+}
+
+type knowledge struct {
+    Months                 int     `yaml:"months"`
+    ConcentrationThreshold float64 `yaml:"concentration_threshold"`
+    BusFactorCoverage      float64 `yaml:"bus_factor_coverage"`
+}
+
 type pagination struct {
     Next     string `yaml:"next"`
     Previous string `yaml:"previous"`
     Exit     string `yaml:"exit"`
 }
 
+type pullRequestSize struct {
+    Thresholds    []int    `yaml:"thresholds"`
+    ExcludedPaths []string `yaml:"excluded_paths"`
+    TopOversized  int      `yaml:"top_oversized"`
+}
+
+type releaseChecklist struct {
+    AlertExitCode  int  `yaml:"alert_exit_code"`
+    RequireChecked bool `yaml:"require_checked"`
+}
+
+type releaseNotes struct {
+    DefaultSection string                `yaml:"default_section"`
+    ExcludedLabels []string              `yaml:"excluded_labels"`
+    Sections       []ReleaseNotesSection `yaml:"sections"`
+}
+
+type reviewers struct {
+    Months            int     `yaml:"months"`
+    MaxFiles          int     `yaml:"max_files"`
+    OwnershipWeight   float64 `yaml:"ownership_weight"`
+    AuthorshipWeight  float64 `yaml:"authorship_weight"`
+    TeamWeight        float64 `yaml:"team_weight"`
+    ReviewLoadPenalty float64 `yaml:"review_load_penalty"`
+}
+
 type service struct {
     Mode string `yaml:"mode"`
     Port string `yaml:"port"`
@@ -75,6 +155,7 @@ type settings struct {
     AvailableClients         []string `yaml:"available_clients"`
     BaseBranch               string   `yaml:"base_branch"`
     DefaultClient            string   `yaml:"default_client"`
+    MaxConcurrentRequests    int      `yaml:"max_concurrent_requests"`
     PageSize                 int      `yaml:"page_size"`
     PullRequestState         string   `yaml:"pull_request_state"`
 }
@@ -85,14 +166,55 @@ type spinner struct {
     Time       time.Duration `yaml:"time"`
 }
 
+type templates struct {
+    Directory string `yaml:"directory"`
+}
+
+// Team describes the members of a team. The members can be either listed explicitly or fetched
+// from the organization team that is defined.
+type Team struct {
+    Members      []string `yaml:"members"`
+    Organization string   `yaml:"organization"`
+    Slug         string   `yaml:"slug"`
+}
+
+// Category describes the rules to classify a pull request in a category. A pull request belongs to the category when
+// any of its labels matches the label patterns, its title starts with any of the title prefixes or its branch name
+// matches any of the branch patterns.
+type Category struct {
+    Name           string   `yaml:"name"`
+    Labels         []string `yaml:"labels"`
+    TitlePrefixes  []string `yaml:"title_prefixes"`
+    BranchPatterns []string `yaml:"branch_patterns"`
+}
+
+// ReleaseNotesSection describes a section of the release notes. A pull request is listed in the section when any of
+// its labels matches the label patterns.
+type ReleaseNotesSection struct {
+    Title  string   `yaml:"title"`
+    Labels []string `yaml:"labels"`
+}
+
 // Config describes the configuration of the service.
 type Config struct {
-    Application application `yaml:"application"`
-    Clients     clients     `yaml:"clients"`
-    Pagination  pagination  `yaml:"pagination"`
-    Service     service     `yaml:"service"`
-    Settings    settings    `yaml:"settings"`
-    Spinner     spinner     `yaml:"spinner"`
+    Aging            aging             `yaml:"aging"`
+    Application      application       `yaml:"application"`
+    Automation       automation        `yaml:"automation"`
+    Categorisation   categorisation    `yaml:"categorisation"`
+    Clients          clients           `yaml:"clients"`
+    Hotspots         hotspots          `yaml:"hotspots"`
+    Knowledge        knowledge         `yaml:"knowledge"`
+    Pagination       pagination        `yaml:"pagination"`
+    PullRequestSize  pullRequestSize   `yaml:"pull_request_size"`
+    ReleaseChecklist releaseChecklist  `yaml:"release_checklist"`
+    ReleaseNotes     releaseNotes      `yaml:"release_notes"`
+    Reviewers        reviewers         `yaml:"reviewers"`
+    Service          service           `yaml:"service"`
+    Services         map[string]string `yaml:"services"`
+    Settings         settings          `yaml:"settings"`
+    Spinner          spinner           `yaml:"spinner"`
+    Templates        templates         `yaml:"templates"`
+    Teams            map[string]Team   `yaml:"teams"`
 }
 
 // New creates and returns a configuration object for the service.
@@ -113,5 +235,13 @@ func New(configFile string) (Config, error) {
         config.Clients.Github.Token.DefaultValue = os.Getenv(config.Clients.Github.Token.DefaultEnvVar)
     }
 
+    if config.Aging.AlertExitCode == 0 {
+        config.Aging.AlertExitCode = defaultAlertExitCode
+    }
+
+    if config.ReleaseChecklist.AlertExitCode == 0 {
+        config.ReleaseChecklist.AlertExitCode = defaultAlertExitCode
+    }
+
     return config, err
 }
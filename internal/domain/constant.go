@@ -3,4 +3,24 @@ package domain
 const (
     CommitListTerminalTemplate = "commitListTerminalTemplate"
     CommitListReleaseTemplate = "commitListReleaseTemplate"
-)
\ No newline at end of file
+    CommitListChangelogTemplate = "commitListChangelogTemplate"
+    CommitListMarkdownTemplate = "commitListMarkdownTemplate"
+    CommitListSlackTemplate = "commitListSlackTemplate"
+    CommitListPlainTemplate = "commitListPlainTemplate"
+)
+
+const (
+    TeamRoleAuthor   = "author"
+    TeamRoleReviewer = "reviewer"
+)
+
+const (
+    ReleaseChecksumFile = "SHA256SUMS"
+)
+
+// The review statuses of a pull request, based on the latest review of each of its reviewers.
+const (
+    ReviewStatusApproved         = "approved"
+    ReviewStatusChangesRequested = "changes_requested"
+    ReviewStatusPending          = "pending"
+)
@@ -1,27 +1,33 @@
 package domain
 
 import (
+    "fmt"
     "math"
+    "strings"
     "time"
 )
 
 // Repository describes the required details to keep for a repo.
 type Repository struct {
-    ID          int    `json:"id"`
-    Name        string `json:"name"`
-    FullName    string `json:"full_name"`
-    Description string `json:"description"`
-    HtmlUrl     string `json:"html_url"`
-    SshUrl      string `json:"ssh_url"`
-    Private     bool   `json:"private"`
-    Language    string `json:"language"`
-    Stars       int    `json:"stargazers_count"`
+    ID          int      `json:"id"`
+    Name        string   `json:"name"`
+    FullName    string   `json:"full_name"`
+    Description string   `json:"description"`
+    HtmlUrl     string   `json:"html_url"`
+    SshUrl      string   `json:"ssh_url"`
+    Private     bool     `json:"private"`
+    Language    string   `json:"language"`
+    Stars       int      `json:"stargazers_count"`
+    Archived    bool     `json:"archived"`
+    Fork        bool     `json:"fork"`
+    Topics      []string `json:"topics"`
 }
 
 // User describes a user account.
 type User struct {
     ID       int    `json:"id"`
     Username string `json:"login"`
+    Type     string `json:"type"`
 }
 
 // Label describes a label of a pull request.
@@ -37,6 +43,12 @@ type PullRequestReview struct {
     SubmittedAt time.Time `json:"submitted_at"`
 }
 
+// PullRequestBranch describes the branch that a pull request has been created from or against.
+type PullRequestBranch struct {
+    Ref string `json:"ref"`
+    Sha string `json:"sha"`
+}
+
 // PullRequest describes the details of a pull request.
 type PullRequest struct {
     ID             int               `json:"id"`
@@ -47,6 +59,8 @@ type PullRequest struct {
     Reviewers      []User            `json:"requested_reviewers"`
     Labels         []Label           `json:"labels"`
     State          string            `json:"state"`
+    Head           PullRequestBranch `json:"head"`
+    Base           PullRequestBranch `json:"base"`
     ReviewStates   map[string]string `json:"reviews"`
     Mergeable      bool              `json:"mergeable"`
     MergeCommitSha string            `json:"merge_commit_sha"`
@@ -63,6 +77,25 @@ type PullRequest struct {
     ChangedFiles   int `json:"changed_files"`
 }
 
+// Comment describes a comment made on an issue or a pull request.
+type Comment struct {
+    ID        int       `json:"id"`
+    User      User      `json:"user"`
+    Body      string    `json:"body"`
+    CreatedAt time.Time `json:"created_at"`
+    UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PullRequestActivity describes the latest activity that took place on a pull request.
+type PullRequestActivity struct {
+    LastPushAt                   time.Time `json:"last_push_at"`
+    LastReviewAt                 time.Time `json:"last_review_at"`
+    LastCommentAt                time.Time `json:"last_comment_at"`
+    LastActivityAt               time.Time `json:"last_activity_at"`
+    IsApproved                   bool      `json:"is_approved"`
+    HasUnaddressedChangeRequests bool      `json:"has_unaddressed_change_requests"`
+}
+
 // Committer describes the
 type Committer struct {
     Name  string    `json:"name"`
@@ -88,15 +121,89 @@ type CommitFile struct {
 
 // Commit describes the information of a commit.
 type Commit struct {
-    Sha     string        `json:"sha"`
-    Url     string        `json:"url"`
-    Details CommitDetails `json:"commit"`
-    Author  User          `json:"author"`
-    Files   []CommitFile  `json:"files"`
+    Sha     string         `json:"sha"`
+    Url     string         `json:"url"`
+    HtmlUrl string         `json:"html_url"`
+    Details CommitDetails  `json:"commit"`
+    Author  User           `json:"author"`
+    Files   []CommitFile   `json:"files"`
+    Parents []CommitParent `json:"parents"`
+}
+
+// CommitParent describes a parent commit of a commit.
+type CommitParent struct {
+    Sha string `json:"sha"`
+}
+
+// CommitGroup describes the commits that have been grouped under the same name, like their author.
+type CommitGroup struct {
+    Name    string   `json:"name"`
+    Commits []Commit `json:"commits"`
+}
+
+// ConventionalCommit describes a commit message parsed based on the conventional commits specification.
+type ConventionalCommit struct {
+    Sha                 string            `json:"sha"`
+    ShortSha            string            `json:"short_sha"`
+    Url                 string            `json:"url"`
+    Author              string            `json:"author"`
+    Type                string            `json:"type"`
+    Scope               string            `json:"scope"`
+    Description         string            `json:"description"`
+    Body                string            `json:"body"`
+    Breaking            bool              `json:"breaking"`
+    BreakingDescription string            `json:"breaking_description"`
+    Footers             map[string]string `json:"footers"`
+    PullRequestNumber   int               `json:"pull_request_number"`
+    PullRequestUrl      string            `json:"pull_request_url"`
+}
+
+// Changelog describes the commits of a range grouped in the sections of a changelog.
+type Changelog struct {
+    BreakingChanges []ConventionalCommit `json:"breaking_changes"`
+    Features        []ConventionalCommit `json:"features"`
+    Fixes           []ConventionalCommit `json:"fixes"`
+    Other           []ConventionalCommit `json:"other"`
+}
+
+// SemanticVersion describes a semantic version along with the prefix and the suffix of the tag it was parsed from.
+type SemanticVersion struct {
+    Prefix     string `json:"prefix"`
+    Major      int    `json:"major"`
+    Minor      int    `json:"minor"`
+    Patch      int    `json:"patch"`
+    PreRelease string `json:"pre_release"`
+    Suffix     string `json:"suffix"`
+}
+
+// String returns the tag of the version, including its prefix, pre-release and suffix.
+func (v SemanticVersion) String() string {
+    if v.PreRelease != "" {
+        return fmt.Sprintf("%v%d.%d.%d-%v%v", v.Prefix, v.Major, v.Minor, v.Patch, v.PreRelease, v.Suffix)
+    }
+
+    return fmt.Sprintf("%v%d.%d.%d%v", v.Prefix, v.Major, v.Minor, v.Patch, v.Suffix)
+}
+
+// ReleaseNotesSection describes the pull requests that are listed in a section of the release notes.
+type ReleaseNotesSection struct {
+    Title        string        `json:"title"`
+    PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// ReleaseNotes describes the merged pull requests of a release, grouped in sections, along with their contributors.
+type ReleaseNotes struct {
+    Repository            string                `json:"repository"`
+    PreviousTag           string                `json:"previous_tag"`
+    Tag                   string                `json:"tag"`
+    Sections              []ReleaseNotesSection `json:"sections"`
+    Contributors          []string              `json:"contributors"`
+    FirstTimeContributors []string              `json:"first_time_contributors"`
 }
 
 // PullRequestMetricDetails describes the pull request lead time details to be kept.
 type PullRequestMetricDetails struct {
+    Repository     string        `json:"repository,omitempty"`
     Number         int           `json:"number"`
     Title          string        `json:"title"`
     LeadTime       time.Duration `json:"lead_time"`
@@ -111,6 +218,13 @@ type PullRequestMetricDetails struct {
     Additions      int `json:"additions"`
     Deletions      int `json:"deletions"`
     ChangedFiles   int `json:"changed_files"`
+
+    Size      string   `json:"size"`
+    SizeLines int      `json:"size_lines"`
+    Category  string   `json:"category"`
+    Owners    []string `json:"owners,omitempty"`
+
+    CycleTimeStages
 }
 
 // TotalAggregation describes the total aggregation metrics of data.
@@ -148,6 +262,8 @@ type PullRequestMetrics struct {
     PRDetails []PullRequestMetricDetails `json:"pr_metrics"`
     Total     TotalAggregation           `json:"total"`
     Average   AverageAggregation         `jsom:"average"`
+
+    Automation AutomationSummary `json:"automation"`
 }
 
 // PullRequestFlowRatio describes the flow ratio information for the pull requests.
@@ -169,6 +285,44 @@ type Release struct {
     PreRelease  bool      `json:"prerelease"`
     CreatedAt   time.Time `json:"created_at"`
     PublishedAt time.Time `json:"published_at"`
+
+    Assets []ReleaseAsset `json:"assets"`
+}
+
+// ReleaseAsset describes the fields of a file attached to a release.
+type ReleaseAsset struct {
+    ID                 int    `json:"id"`
+    Name               string `json:"name"`
+    ContentType        string `json:"content_type"`
+    State              string `json:"state"`
+    Size               int64  `json:"size"`
+    DownloadCount      int    `json:"download_count"`
+    BrowserDownloadUrl string `json:"browser_download_url"`
+}
+
+// ReleaseChecklist describes the checklist of the description of a release, along with the authors of the unchecked
+// items.
+type ReleaseChecklist struct {
+    Items            []ReleaseChecklistItem `json:"items"`
+    Checked          int                    `json:"checked"`
+    Unchecked        int                    `json:"unchecked"`
+    UncheckedAuthors []string               `json:"unchecked_authors"`
+}
+
+// ReleaseChecklistItem describes an item of the checklist of a release.
+type ReleaseChecklistItem struct {
+    Line    int    `json:"line"`
+    Checked bool   `json:"checked"`
+    Author  string `json:"author"`
+    Text    string `json:"text"`
+}
+
+// ReleaseUpdate describes the fields of a release to be updated. The fields that are not set are left as they are.
+type ReleaseUpdate struct {
+    Name       *string `json:"name,omitempty"`
+    Body       *string `json:"body,omitempty"`
+    Draft      *bool   `json:"draft,omitempty"`
+    PreRelease *bool   `json:"prerelease,omitempty"`
 }
 
 // ReleaseReport describes the fields to generate reports for releases.
@@ -181,6 +335,12 @@ type ReleaseReport struct {
     CreatedToPublishedRatio float64 `json:"created_to_published_ratio"`
 }
 
+// RepositoryReleaseReport describes the release report of a repository, when the report spans multiple repositories.
+type RepositoryReleaseReport struct {
+    Repository string `json:"repository"`
+    ReleaseReport
+}
+
 // CalculateRatioFields of the release report.
 func (rr *ReleaseReport) CalculateRatioFields() {
     if rr.NumberOfReleasesPublished > 0 {
@@ -242,3 +402,478 @@ type WorkflowCosts struct {
     ExecMinutes int64
     Cost        float32
 }
+
+// AgingPullRequest describes the aging details of an open pull request.
+type AgingPullRequest struct {
+    Repository       string              `json:"repository"`
+    Number           int                 `json:"number"`
+    Title            string              `json:"title"`
+    HtmlUrl          string              `json:"html_url"`
+    Author           string              `json:"author"`
+    CreatedAt        time.Time           `json:"created_at"`
+    Activity         PullRequestActivity `json:"activity"`
+    Age              time.Duration       `json:"age"`
+    Inactivity       time.Duration       `json:"inactivity"`
+    StrAge           string              `json:"str_age"`
+    StrInactivity    string              `json:"str_inactivity"`
+    AgeBucket        string              `json:"age_bucket"`
+    InactivityBucket string              `json:"inactivity_bucket"`
+    IsStale          bool                `json:"is_stale"`
+}
+
+// AgingBucket describes the number of pull requests that fall in an age range.
+type AgingBucket struct {
+    Bucket       string `json:"bucket"`
+    ByAge        int    `json:"by_age"`
+    ByInactivity int    `json:"by_inactivity"`
+}
+
+// AgingReport describes the aging details of the open pull requests.
+type AgingReport struct {
+    PullRequests       []AgingPullRequest `json:"pull_requests"`
+    Buckets            []AgingBucket      `json:"buckets"`
+    Stale              int                `json:"stale"`
+    ApprovedNotMerged  int                `json:"approved_not_merged"`
+    UnaddressedChanges int                `json:"unaddressed_changes"`
+    Automated          int                `json:"automated"`
+}
+
+// PullRequestSizeBucket describes the pull requests that fall in a size bucket.
+type PullRequestSizeBucket struct {
+    Size           string        `json:"size"`
+    Count          int           `json:"count"`
+    Lines          int           `json:"lines"`
+    AvgLeadTime    time.Duration `json:"avg_lead_time"`
+    StrAvgLeadTime string        `json:"str_avg_lead_time"`
+}
+
+// PullRequestSizePeriod describes the size distribution of the pull requests created during a period.
+type PullRequestSizePeriod struct {
+    Period string         `json:"period"`
+    Sizes  map[string]int `json:"sizes"`
+}
+
+// PullRequestSizeReport describes the size distribution of pull requests.
+type PullRequestSizeReport struct {
+    Buckets             []PullRequestSizeBucket    `json:"buckets"`
+    Periods             []PullRequestSizePeriod    `json:"periods"`
+    LeadTimeCorrelation float64                    `json:"lead_time_correlation"`
+    Oversized           []PullRequestMetricDetails `json:"oversized"`
+}
+
+// TimelineEvent describes an event of the timeline of an issue or a pull request.
+type TimelineEvent struct {
+    Event       string    `json:"event"`
+    Actor       User      `json:"actor"`
+    User        User      `json:"user"`
+    State       string    `json:"state"`
+    Committer   Committer `json:"committer"`
+    CreatedAt   time.Time `json:"created_at"`
+    SubmittedAt time.Time `json:"submitted_at"`
+
+    RequestedReviewer User `json:"requested_reviewer"`
+}
+
+// GetDate returns the date that the event took place, which depends on the type of the event.
+func (e TimelineEvent) GetDate() time.Time {
+    switch {
+    case !e.CreatedAt.IsZero():
+        return e.CreatedAt
+    case !e.SubmittedAt.IsZero():
+        return e.SubmittedAt
+    default:
+        return e.Committer.Date
+    }
+}
+
+// CycleTimeStages describes the time that a pull request spent in each stage of its lifecycle.
+type CycleTimeStages struct {
+    Coding           time.Duration `json:"coding_time"`
+    Draft            time.Duration `json:"draft_time"`
+    WaitingForReview time.Duration `json:"waiting_for_review_time"`
+    InReview         time.Duration `json:"in_review_time"`
+    WaitingToMerge   time.Duration `json:"waiting_to_merge_time"`
+
+    StrCoding           string `json:"str_coding_time"`
+    StrDraft            string `json:"str_draft_time"`
+    StrWaitingForReview string `json:"str_waiting_for_review_time"`
+    StrInReview         string `json:"str_in_review_time"`
+    StrWaitingToMerge   string `json:"str_waiting_to_merge_time"`
+}
+
+// GetTotal returns the total time of all the stages.
+func (c CycleTimeStages) GetTotal() time.Duration {
+    return c.Coding + c.Draft + c.WaitingForReview + c.InReview + c.WaitingToMerge
+}
+
+// CycleTimePullRequest describes the cycle time stages of a pull request.
+type CycleTimePullRequest struct {
+    Number   int       `json:"number"`
+    Title    string    `json:"title"`
+    Author   string    `json:"author"`
+    MergedAt time.Time `json:"merged_at"`
+
+    CycleTimeStages
+}
+
+// CycleTimeReport describes the cycle time stages of a set of pull requests.
+type CycleTimeReport struct {
+    PullRequests []CycleTimePullRequest `json:"pull_requests"`
+    Average      CycleTimeStages        `json:"average"`
+}
+
+// AutomationSummary describes the pull requests that have been created by bots and automation accounts.
+type AutomationSummary struct {
+    PullRequests   int            `json:"pull_requests"`
+    Merged         int            `json:"merged"`
+    AvgLeadTime    time.Duration  `json:"avg_lead_time"`
+    StrAvgLeadTime string         `json:"str_avg_lead_time"`
+    Authors        map[string]int `json:"authors"`
+}
+
+// MetricDelta describes the change of a metric compared to its value in a baseline period.
+type MetricDelta struct {
+    Current    float64 `json:"current"`
+    Baseline   float64 `json:"baseline"`
+    Absolute   float64 `json:"absolute"`
+    Percentage float64 `json:"percentage"`
+}
+
+// PullRequestMetricsDelta describes the changes of the pull request metrics compared to a baseline period. The lead
+// time and the time to merge are expressed in hours and the size in modified lines.
+type PullRequestMetricsDelta struct {
+    BaselineStartDate string      `json:"baseline_start_date"`
+    BaselineEndDate   string      `json:"baseline_end_date"`
+    PullRequests      MetricDelta `json:"pull_requests"`
+    Merged            MetricDelta `json:"merged"`
+    AvgLeadTime       MetricDelta `json:"avg_lead_time"`
+    AvgTimeToMerge    MetricDelta `json:"avg_time_to_merge"`
+    AvgSize           MetricDelta `json:"avg_size"`
+    FlowRatio         MetricDelta `json:"flow_ratio"`
+}
+
+// CategoryBreakdown describes the share of the pull requests and of the modified lines that belong to a category.
+type CategoryBreakdown struct {
+    Category               string  `json:"category"`
+    PullRequests           int     `json:"pull_requests"`
+    Lines                  int     `json:"lines"`
+    PullRequestsPercentage float64 `json:"pull_requests_percentage"`
+    LinesPercentage        float64 `json:"lines_percentage"`
+}
+
+// CategoryPeriod describes the share of the pull requests and of the modified lines per category during a period.
+type CategoryPeriod struct {
+    Period     string              `json:"period"`
+    Categories []CategoryBreakdown `json:"categories"`
+}
+
+// FileHotspot describes how frequently and how much a file has been modified.
+type FileHotspot struct {
+    Path      string   `json:"path"`
+    Changes   int      `json:"changes"`
+    Additions int      `json:"additions"`
+    Deletions int      `json:"deletions"`
+    Churn     int      `json:"churn"`
+    Authors   []string `json:"authors"`
+}
+
+// DirectoryHotspot describes how frequently and how much the files of a directory have been modified.
+type DirectoryHotspot struct {
+    Path      string   `json:"path"`
+    Changes   int      `json:"changes"`
+    Files     int      `json:"files"`
+    Additions int      `json:"additions"`
+    Deletions int      `json:"deletions"`
+    Churn     int      `json:"churn"`
+    Authors   []string `json:"authors"`
+}
+
+// HotspotReport describes the most frequently modified files and directories of a repository.
+type HotspotReport struct {
+    Commits     int                `json:"commits"`
+    Files       []FileHotspot      `json:"files"`
+    Directories []DirectoryHotspot `json:"directories"`
+    Owners      []OwnerHotspot     `json:"owners,omitempty"`
+}
+
+// OwnerHotspot describes how frequently and how much the files owned by a code owner have been modified.
+type OwnerHotspot struct {
+    Owner     string   `json:"owner"`
+    Changes   int      `json:"changes"`
+    Files     int      `json:"files"`
+    Additions int      `json:"additions"`
+    Deletions int      `json:"deletions"`
+    Churn     int      `json:"churn"`
+    Authors   []string `json:"authors"`
+}
+
+// RepositoryContent describes a file of a repository as retrieved from the contents api.
+type RepositoryContent struct {
+    Name     string `json:"name"`
+    Path     string `json:"path"`
+    Sha      string `json:"sha"`
+    Size     int    `json:"size"`
+    Encoding string `json:"encoding"`
+    Content  string `json:"content"`
+}
+
+// RepositoryContentUpdate describes the outcome of creating or updating a file of a repository.
+type RepositoryContentUpdate struct {
+    Content RepositoryContent `json:"content"`
+    Commit  Commit            `json:"commit"`
+}
+
+// Tag describes a tag of a repository along with the commit it points to.
+type Tag struct {
+    Name   string `json:"name"`
+    Commit Commit `json:"commit"`
+}
+
+// GitObject describes the object that a git reference points to.
+type GitObject struct {
+    Sha  string `json:"sha"`
+    Type string `json:"type"`
+}
+
+// GitReference describes a git reference of a repository, like a branch.
+type GitReference struct {
+    Ref    string    `json:"ref"`
+    Object GitObject `json:"object"`
+}
+
+// RepositoryTreeEntry describes a file or a directory of the tree of a repository.
+type RepositoryTreeEntry struct {
+    Path string `json:"path"`
+    Type string `json:"type"`
+    Sha  string `json:"sha"`
+}
+
+// RepositoryTree describes the tree of a repository at a specific reference.
+type RepositoryTree struct {
+    Sha       string                `json:"sha"`
+    Tree      []RepositoryTreeEntry `json:"tree"`
+    Truncated bool                  `json:"truncated"`
+}
+
+// CodeOwnersRule describes a line of a CODEOWNERS file. A rule without owners marks the matching files as unowned.
+type CodeOwnersRule struct {
+    Line    int      `json:"line"`
+    Pattern string   `json:"pattern"`
+    Owners  []string `json:"owners"`
+}
+
+// CodeOwners describes the rules of the CODEOWNERS file of a repository.
+type CodeOwners struct {
+    Path  string           `json:"path"`
+    Rules []CodeOwnersRule `json:"rules"`
+}
+
+// OwnerBreakdown describes the pull requests that modified files owned by a code owner.
+type OwnerBreakdown struct {
+    Owner          string        `json:"owner"`
+    PullRequests   int           `json:"pull_requests"`
+    Merged         int           `json:"merged"`
+    Lines          int           `json:"lines"`
+    AvgLeadTime    time.Duration `json:"avg_lead_time"`
+    StrAvgLeadTime string        `json:"str_avg_lead_time"`
+}
+
+// RepositoryBreakdown describes the pull requests of a repository, when the metrics span multiple repositories.
+type RepositoryBreakdown struct {
+    Repository        string        `json:"repository"`
+    PullRequests      int           `json:"pull_requests"`
+    Merged            int           `json:"merged"`
+    Lines             int           `json:"lines"`
+    AvgLeadTime       time.Duration `json:"avg_lead_time"`
+    StrAvgLeadTime    string        `json:"str_avg_lead_time"`
+    AvgTimeToMerge    time.Duration `json:"avg_time_to_merge"`
+    StrAvgTimeToMerge string        `json:"str_avg_time_to_merge"`
+}
+
+// CodeOwnersViolation describes a merged pull request that modified files without the approval of their owners.
+type CodeOwnersViolation struct {
+    Number          int       `json:"number"`
+    Title           string    `json:"title"`
+    HtmlUrl         string    `json:"html_url"`
+    Author          string    `json:"author"`
+    MergedAt        time.Time `json:"merged_at"`
+    UnapprovedFiles []string  `json:"unapproved_files"`
+    MissingOwners   []string  `json:"missing_owners"`
+}
+
+// CodeOwnersReport describes the files without owners and the pull requests merged without the approval of an owner.
+type CodeOwnersReport struct {
+    Path         string                `json:"path"`
+    Files        int                   `json:"files"`
+    UnownedFiles []string              `json:"unowned_files"`
+    PullRequests int                   `json:"pull_requests"`
+    Violations   []CodeOwnersViolation `json:"violations"`
+}
+
+// KnowledgeContributor describes the changes that an author made in a directory.
+type KnowledgeContributor struct {
+    Author  string  `json:"author"`
+    Changes int     `json:"changes"`
+    Churn   int     `json:"churn"`
+    Share   float64 `json:"share"`
+}
+
+// DirectoryKnowledge describes how concentrated the knowledge of a directory is among its contributors. The bus
+// factor is the smallest number of contributors that cover the configured share of the changes of the directory.
+type DirectoryKnowledge struct {
+    Path                string                 `json:"path"`
+    Changes             int                    `json:"changes"`
+    Churn               int                    `json:"churn"`
+    TopContributor      string                 `json:"top_contributor"`
+    TopContributorShare float64                `json:"top_contributor_share"`
+    BusFactor           int                    `json:"bus_factor"`
+    IsConcentrated      bool                   `json:"is_concentrated"`
+    Contributors        []KnowledgeContributor `json:"contributors"`
+}
+
+// KnowledgeReport describes the knowledge concentration of the directories of a repository.
+type KnowledgeReport struct {
+    Commits      int                  `json:"commits"`
+    Concentrated int                  `json:"concentrated"`
+    Directories  []DirectoryKnowledge `json:"directories"`
+}
+
+// PullRequestReviewers describes the users that reviewed a pull request.
+type PullRequestReviewers struct {
+    Repository string   `json:"repository"`
+    Number     int      `json:"number"`
+    Author     string   `json:"author"`
+    Reviewers  []string `json:"reviewers"`
+}
+
+// ReviewEdge describes the number of pull requests of an author that have been reviewed by a reviewer.
+type ReviewEdge struct {
+    Author   string `json:"author"`
+    Reviewer string `json:"reviewer"`
+    Weight   int    `json:"weight"`
+}
+
+// ReviewGraphStats describes the summary of a review graph. The reciprocity is the percentage of the edges whose
+// reverse edge exists as well.
+type ReviewGraphStats struct {
+    PullRequests          int      `json:"pull_requests"`
+    Unreviewed            int      `json:"unreviewed"`
+    Authors               int      `json:"authors"`
+    Reviewers             int      `json:"reviewers"`
+    Edges                 int      `json:"edges"`
+    Reciprocity           float64  `json:"reciprocity"`
+    SingleAuthorReviewers []string `json:"single_author_reviewers"`
+    SingleReviewerAuthors []string `json:"single_reviewer_authors"`
+}
+
+// ReviewGraph describes who reviews whom as a weighted graph from the authors to the reviewers.
+type ReviewGraph struct {
+    Edges     []ReviewEdge              `json:"edges"`
+    Adjacency map[string]map[string]int `json:"adjacency"`
+    Stats     ReviewGraphStats          `json:"stats"`
+}
+
+// ReviewerWeights describes the weights of the signals that the candidate reviewers of a pull request are ranked with.
+type ReviewerWeights struct {
+    Ownership  float64 `json:"ownership"`
+    Authorship float64 `json:"authorship"`
+    Team       float64 `json:"team"`
+    ReviewLoad float64 `json:"review_load"`
+}
+
+// ReviewerCandidate describes a candidate reviewer of a pull request along with the signals that it has been ranked
+// with. The owned and authored files refer to the modified files of the pull request.
+type ReviewerCandidate struct {
+    Username      string  `json:"username"`
+    OwnedFiles    int     `json:"owned_files"`
+    AuthoredFiles int     `json:"authored_files"`
+    RecentCommits int     `json:"recent_commits"`
+    OpenReviews   int     `json:"open_reviews"`
+    IsTeamMember  bool    `json:"is_team_member"`
+    Score         float64 `json:"score"`
+}
+
+// ReviewerSuggestion describes the ranked candidate reviewers of a pull request and the ones that have been requested.
+type ReviewerSuggestion struct {
+    Number     int                 `json:"number"`
+    Title      string              `json:"title"`
+    Author     string              `json:"author"`
+    HtmlUrl    string              `json:"html_url"`
+    Files      int                 `json:"files"`
+    Candidates []ReviewerCandidate `json:"candidates"`
+    Requested  []string            `json:"requested"`
+}
+
+// SearchIssue describes an issue or a pull request that has been returned by a search.
+type SearchIssue struct {
+    Number        int                    `json:"number"`
+    Title         string                 `json:"title"`
+    HtmlUrl       string                 `json:"html_url"`
+    RepositoryUrl string                 `json:"repository_url"`
+    Creator       User                   `json:"user"`
+    Draft         bool                   `json:"draft"`
+    CreatedAt     time.Time              `json:"created_at"`
+    UpdatedAt     time.Time              `json:"updated_at"`
+    PullRequest   SearchIssuePullRequest `json:"pull_request"`
+}
+
+// SearchIssuePullRequest describes the pull request details of an issue that has been returned by a search.
+type SearchIssuePullRequest struct {
+    MergedAt time.Time `json:"merged_at"`
+}
+
+// GetRepository returns the full name of the repository that the issue belongs to.
+func (i SearchIssue) GetRepository() string {
+    idx := strings.Index(i.RepositoryUrl, "/repos/")
+    if idx < 0 {
+        return i.RepositoryUrl
+    }
+
+    return i.RepositoryUrl[idx+len("/repos/"):]
+}
+
+// SearchIssuesResponse describes the response of a search for issues and pull requests.
+type SearchIssuesResponse struct {
+    TotalCount int           `json:"total_count"`
+    Items      []SearchIssue `json:"items"`
+}
+
+// InboxItem describes a pull request that waits for an action of a user along with the reason and the time since it
+// waits for it.
+type InboxItem struct {
+    Repository   string        `json:"repository"`
+    Number       int           `json:"number"`
+    Title        string        `json:"title"`
+    HtmlUrl      string        `json:"html_url"`
+    Author       string        `json:"author"`
+    Reason       string        `json:"reason"`
+    WaitingSince time.Time     `json:"waiting_since"`
+    Waiting      time.Duration `json:"waiting"`
+    StrWaiting   string        `json:"str_waiting"`
+}
+
+// PullRequestFilter describes the criteria that the pull requests need to match. Empty criteria match any pull request.
+type PullRequestFilter struct {
+    Creators     []string `json:"creators"`
+    Reviewers    []string `json:"reviewers"`
+    Labels       []string `json:"labels"`
+    ReviewStatus string   `json:"review_status"`
+}
+
+// RepositorySelector describes the criteria to select the repositories to use. The repositories are retrieved from the
+// organization team that the team refers to, the organization or the authenticated user, in this order of precedence,
+// and then filtered by the rest of the criteria. Empty criteria match any repository.
+type RepositorySelector struct {
+    Organization    string   `json:"organization"`
+    Team            string   `json:"team"`
+    Topics          []string `json:"topics"`
+    Languages       []string `json:"languages"`
+    NameRegex       string   `json:"name_regex"`
+    ExcludeArchived bool     `json:"exclude_archived"`
+    ExcludeForks    bool     `json:"exclude_forks"`
+}
+
+// IsEmpty checks whether no criteria have been defined in the selector.
+func (s RepositorySelector) IsEmpty() bool {
+    return s.Organization == "" && s.Team == "" && len(s.Topics) == 0 && len(s.Languages) == 0 && s.NameRegex == "" && !s.ExcludeArchived && !s.ExcludeForks
+}
@@ -0,0 +1,182 @@
+package aging
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	defaultPageSize = 50
+	openPrState     = "open"
+)
+
+type userReposService interface {
+	GetRepositoryNames(authToken, owner string, repositories []string, selector domain.RepositorySelector) ([]string, error)
+}
+
+type pullRequestService interface {
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
+	GetPullRequestActivity(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequestActivity, error)
+	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+}
+
+type tablePrinter interface {
+	PrintAgingReport(agingReport domain.AgingReport)
+}
+
+type utilities interface {
+	ConvertDurationToString(dur time.Duration) string
+	GetDurationBucket(dur time.Duration, thresholdsInDays []int) string
+	GetDurationBucketLabels(thresholdsInDays []int) []string
+}
+
+// NewCmd creates a new command to report the aging of the open pull requests of repositories.
+func NewCmd(cfg config.Config, userReposService userReposService, pullRequestService pullRequestService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+	var authToken, baseBranch string
+	var repositories cli.StringSlice
+	var repositorySelector flag.RepositorySelector
+	var printJson, failOnStale, includeBots bool
+
+	flagBuilder := flag.New(cfg)
+
+	agingCmd := cli.Command{
+		Name:    "aging",
+		Aliases: []string{"ag"},
+		Usage:   "Retrieves the open pull requests of the provided repositories and reports them bucketed by age and by time since their last activity.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendRepositoriesFlag(&repositories, false).
+			AppendRepositorySelectorFlags(&repositorySelector).
+			AppendAnyBaseFlag(&baseBranch).
+			AppendPrintJsonFlag(&printJson).
+			AppendFailOnStaleFlag(&failOnStale).
+			AppendIncludeBotsFlag(&includeBots).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			now := time.Now()
+			bucketThresholds := cfg.Aging.AgeBucketsInDays
+			staleAfter := time.Duration(cfg.Aging.StaleAfterDays) * 24 * time.Hour
+
+			agingReport := domain.AgingReport{}
+			bucketIndex := make(map[string]int)
+			for idx, label := range utilities.GetDurationBucketLabels(bucketThresholds) {
+				agingReport.Buckets = append(agingReport.Buckets, domain.AgingBucket{Bucket: label})
+				bucketIndex[label] = idx
+			}
+
+			repoNames, err := userReposService.GetRepositoryNames(authToken, "", repositories.Value(), repositorySelector.Get())
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			for _, repoFullName := range repoNames {
+				details := strings.Split(repoFullName, "/")
+
+				currentPage := 1
+				for {
+					prResp, err := pullRequestService.GetPullRequestsOfRepository(authToken, details[0], details[1], baseBranch, openPrState, defaultPageSize, currentPage)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Println(err)
+						return err
+					}
+
+					pullRequests, automatedPullRequests := pullRequestService.FilterAutomatedPullRequests(prResp.PullRequests, includeBots)
+					agingReport.Automated += len(automatedPullRequests)
+
+					for _, pr := range pullRequests {
+						activity, err := pullRequestService.GetPullRequestActivity(authToken, details[0], details[1], pr.Number)
+						if err != nil {
+							spinLoader.Stop()
+							fmt.Printf("Failed to get the activity of pull request #%v of %v with error : %v\n", pr.Number, repoFullName, err)
+							return err
+						}
+
+						age := now.Sub(pr.CreatedAt)
+						inactivity := now.Sub(activity.LastActivityAt)
+
+						agingPr := domain.AgingPullRequest{
+							Repository:       repoFullName,
+							Number:           pr.Number,
+							Title:            pr.Title,
+							HtmlUrl:          pr.HtmlUrl,
+							Author:           pr.Creator.Username,
+							CreatedAt:        pr.CreatedAt,
+							Activity:         activity,
+							Age:              age,
+							Inactivity:       inactivity,
+							StrAge:           utilities.ConvertDurationToString(age),
+							StrInactivity:    utilities.ConvertDurationToString(inactivity),
+							AgeBucket:        utilities.GetDurationBucket(age, bucketThresholds),
+							InactivityBucket: utilities.GetDurationBucket(inactivity, bucketThresholds),
+							IsStale:          staleAfter > 0 && inactivity >= staleAfter,
+						}
+
+						agingReport.Buckets[bucketIndex[agingPr.AgeBucket]].ByAge++
+						agingReport.Buckets[bucketIndex[agingPr.InactivityBucket]].ByInactivity++
+
+						if agingPr.IsStale {
+							agingReport.Stale++
+						}
+						if activity.IsApproved {
+							agingReport.ApprovedNotMerged++
+						}
+						if activity.HasUnaddressedChangeRequests {
+							agingReport.UnaddressedChanges++
+						}
+
+						agingReport.PullRequests = append(agingReport.PullRequests, agingPr)
+					}
+
+					if len(prResp.PullRequests) < defaultPageSize {
+						break
+					}
+
+					currentPage++
+				}
+			}
+
+			sort.Slice(agingReport.PullRequests, func(i, j int) bool {
+				return agingReport.PullRequests[i].Inactivity > agingReport.PullRequests[j].Inactivity
+			})
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(agingReport)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else {
+				fmt.Printf("Number of open pull requests : %v\n", len(agingReport.PullRequests))
+				fmt.Println()
+				tablePrinter.PrintAgingReport(agingReport)
+			}
+
+			if failOnStale && agingReport.Stale > 0 {
+				return cli.Exit(fmt.Sprintf("Found %v stale pull requests.", agingReport.Stale), cfg.Aging.AlertExitCode)
+			}
+
+			return nil
+		},
+	}
+
+	return &agingCmd
+}
@@ -1,17 +1,28 @@
 package command
 
 import (
+	"io"
 	"time"
 
 	"github.com/eujoy/gitpr/internal/config"
 	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/command/aging"
+	"github.com/eujoy/gitpr/internal/infra/command/changelog"
+	"github.com/eujoy/gitpr/internal/infra/command/codeowners"
 	"github.com/eujoy/gitpr/internal/infra/command/commitlist"
 	"github.com/eujoy/gitpr/internal/infra/command/createrelease"
+	"github.com/eujoy/gitpr/internal/infra/command/cycletime"
 	"github.com/eujoy/gitpr/internal/infra/command/find"
+	"github.com/eujoy/gitpr/internal/infra/command/hotspots"
+	"github.com/eujoy/gitpr/internal/infra/command/inbox"
+	"github.com/eujoy/gitpr/internal/infra/command/knowledge"
 	"github.com/eujoy/gitpr/internal/infra/command/prmetrics"
 	"github.com/eujoy/gitpr/internal/infra/command/publishmetrics"
 	"github.com/eujoy/gitpr/internal/infra/command/pullrequests"
+	"github.com/eujoy/gitpr/internal/infra/command/release"
 	"github.com/eujoy/gitpr/internal/infra/command/releasereport"
+	"github.com/eujoy/gitpr/internal/infra/command/reviewgraph"
+	"github.com/eujoy/gitpr/internal/infra/command/suggestreviewers"
 	"github.com/eujoy/gitpr/internal/infra/command/userrepos"
 	"github.com/eujoy/gitpr/internal/infra/command/widget"
 	"github.com/eujoy/gitpr/internal/infra/command/workflows"
@@ -19,23 +30,49 @@ import (
 )
 
 type userReposService interface {
+	GetAuthenticatedUser(authToken string) (domain.User, error)
 	GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error)
+	GetRepositoryNames(authToken, owner string, repositories []string, selector domain.RepositorySelector) ([]string, error)
+	SelectRepositories(authToken string, selector domain.RepositorySelector) ([]domain.Repository, error)
 }
 
 type pullRequestsService interface {
+	GetPullRequestActivity(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequestActivity, error)
 	GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error)
+	GetPullRequestReviews(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error)
+	GetPullRequestTimeline(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.TimelineEvent, error)
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+	RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
+	FilterPullRequests(pullRequests []domain.PullRequest, filter domain.PullRequestFilter) []domain.PullRequest
+	SearchPullRequests(authToken, query string) ([]domain.SearchIssue, error)
 }
 
 type repositoryService interface {
+	CreatePullRequestWithFile(authToken, repoOwner, repository, baseBranch, headBranch, path, content, sha, message, title, body string) (domain.PullRequest, error)
 	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
+	GetCommitsWithFiles(authToken, repoOwner, repository, branch, startTag, endTag string, since, until time.Time, skipCommit func(commit domain.Commit) bool, isExcluded func(filename string) bool) ([]domain.Commit, error)
+	GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
 	CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error
+	DeleteRelease(authToken, repoOwner, repository string, releaseID int) error
 	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
+	GetReleaseByTag(authToken, repoOwner, repository, tag string) (domain.Release, error)
 	GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
+	UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error)
+	UploadReleaseAsset(authToken, repoOwner, repository string, release domain.Release, name string, content io.Reader, size int64, replace bool) (domain.ReleaseAsset, bool, error)
+	GetFileContent(authToken, repoOwner, repository, path, ref string) (string, string, error)
+	GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error)
+	GetTemplateNames() []string
 	PrintCommitList(commitList []domain.Commit, useTmpl string) (string, error)
 }
 
+type releaseNotesService interface {
+	BuildReleaseNotes(authToken, repoOwner, repository, previousTag, tag string, commitList []domain.Commit) (domain.ReleaseNotes, error)
+	PrintReleaseNotes(releaseNotes domain.ReleaseNotes, templateFile string) (string, error)
+}
+
 type workflowService interface {
 	GetWorkflowExecutions(authToken, repoOwner, repository, startDateStr, endDateStr string, pageSize, pageNumber int) ([]domain.Workflow, error)
 	GetWorkflowsOfRepository(authToken, repoOwner, repository string) ([]domain.Workflow, error)
@@ -43,20 +80,77 @@ type workflowService interface {
 	GetWorkflowUsage(authToken, repoOwner, repository string, workflowID int) (domain.WorkflowTiming, error)
 }
 
+type teamsService interface {
+	GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+	GetTeamMembers(authToken, teamName string) ([]string, error)
+	FilterPullRequests(pullRequests []domain.PullRequest, members []string, teamRole string) []domain.PullRequest
+	IsTeamPullRequest(pullRequest domain.PullRequest, members []string, teamRole string) bool
+}
+
+type automationService interface {
+	GetAutomationSummary(automatedPullRequests []domain.PullRequest) domain.AutomationSummary
+	IsAutomatedUser(user domain.User) bool
+}
+
+type categoriesService interface {
+	GetCategory(pullRequest domain.PullRequest) string
+	GetCategoryNames() []string
+}
+
+type codeOwnersService interface {
+	GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error)
+	GetFileOwners(codeOwners domain.CodeOwners, filename string) []string
+	GetOwnerMembers(authToken, owner string) ([]string, error)
+	GetPullRequestOwners(codeOwners domain.CodeOwners, files []domain.CommitFile) []string
+	GetRepositoryFiles(authToken, repoOwner, repository, ref string) ([]string, error)
+	GetUnapprovedFiles(authToken, repoOwner, repository string, pullRequestNumber int, codeOwners domain.CodeOwners, files []domain.CommitFile) ([]string, []string, error)
+}
+
 type tablePrinter interface {
 	PrintRepos(repos []domain.Repository)
 	PrintPullRequest(pullRequests []domain.PullRequest)
 	PrintPullRequestFlowRatio(flowRatioData map[string]*domain.PullRequestFlowRatio)
 	PrintPullRequestMetrics(pullRequests domain.PullRequestMetrics)
+	PrintReleaseChecklist(checklist domain.ReleaseChecklist)
+	PrintReleases(releases []domain.Release)
 	PrintReleaseReport(releaseReport domain.ReleaseReport, captionText string)
 	PrintWorkflowCosts(workflowBilling []domain.WorkflowBilling)
+	PrintAgingReport(agingReport domain.AgingReport)
+	PrintPullRequestSizeReport(sizeReport domain.PullRequestSizeReport)
+	PrintCycleTimeReport(cycleTimeReport domain.CycleTimeReport)
+	PrintAutomationSummary(automationSummary domain.AutomationSummary)
+	PrintPullRequestMetricsDelta(metricsDelta domain.PullRequestMetricsDelta)
+	PrintCategoryBreakdown(categoryBreakdown []domain.CategoryBreakdown)
+	PrintCategoryPeriods(categoryPeriods []domain.CategoryPeriod)
+	PrintHotspotReport(hotspotReport domain.HotspotReport)
+	PrintHotspotReportCsv(hotspotReport domain.HotspotReport)
+	PrintOwnerBreakdown(ownerBreakdown []domain.OwnerBreakdown)
+	PrintRepositoryBreakdown(repositoryBreakdown []domain.RepositoryBreakdown)
+	PrintReleaseReportBreakdown(repositoryReports []domain.RepositoryReleaseReport, releaseReport domain.ReleaseReport, captionText string)
+	PrintCodeOwnersReport(codeOwnersReport domain.CodeOwnersReport)
+	PrintKnowledgeReport(knowledgeReport domain.KnowledgeReport)
+	PrintReviewGraph(reviewGraph domain.ReviewGraph)
+	PrintReviewGraphDot(reviewGraph domain.ReviewGraph)
+	PrintReviewGraphMermaid(reviewGraph domain.ReviewGraph)
+	PrintReviewerSuggestion(reviewerSuggestion domain.ReviewerSuggestion)
+	PrintInbox(inboxItems []domain.InboxItem)
 }
 
 type utilities interface {
+	BuildChecksums(files []string) (string, error)
 	ClearTerminalScreen()
+	Confirm(force bool, message string) (bool, error)
+	IsTerminal() bool
 	GetPageOptions(respLength int, pageSize int, currentPage int) []string
 	GetNextPageNumberOrExit(surveySelection string, currentPage int) (int, bool)
+	GetValidPullRequestState(prState string) string
+	ConvertCycleTimeStagesToString(stages domain.CycleTimeStages) domain.CycleTimeStages
 	ConvertDurationToString(dur time.Duration) string
+	GetDurationBucket(dur time.Duration, thresholdsInDays []int) string
+	GetDurationBucketLabels(thresholdsInDays []int) []string
+	GetAssetFiles(patterns []string) ([]string, error)
+	MatchesRepositoryPattern(repository, pattern string) bool
+	RunConcurrently(count int, fn func(idx int) error) error
 }
 
 // Builder describes the builder of the cli commands.
@@ -67,12 +161,17 @@ type Builder struct {
 	pullRequestsService pullRequestsService
 	repositoryService   repositoryService
 	workflowService     workflowService
+	teamsService        teamsService
+	automationService   automationService
+	categoriesService   categoriesService
+	codeOwnersService   codeOwnersService
+	releaseNotesService releaseNotesService
 	tablePrinter        tablePrinter
 	utils               utilities
 }
 
 // NewBuilder creates and returns a new command builder.
-func NewBuilder(cfg config.Config, userReposService userReposService, pullRequestsService pullRequestsService, repositoryService repositoryService, workflowService workflowService, tablePrinter tablePrinter, utils utilities) *Builder {
+func NewBuilder(cfg config.Config, userReposService userReposService, pullRequestsService pullRequestsService, repositoryService repositoryService, workflowService workflowService, teamsService teamsService, automationService automationService, categoriesService categoriesService, codeOwnersService codeOwnersService, releaseNotesService releaseNotesService, tablePrinter tablePrinter, utils utilities) *Builder {
 	return &Builder{
 		commands:            []*cli.Command{},
 		cfg:                 cfg,
@@ -80,6 +179,11 @@ func NewBuilder(cfg config.Config, userReposService userReposService, pullReques
 		pullRequestsService: pullRequestsService,
 		repositoryService:   repositoryService,
 		workflowService:     workflowService,
+		teamsService:        teamsService,
+		automationService:   automationService,
+		categoriesService:   categoriesService,
+		codeOwnersService:   codeOwnersService,
+		releaseNotesService: releaseNotesService,
 		tablePrinter:        tablePrinter,
 		utils:               utils,
 	}
@@ -100,7 +204,7 @@ func (b *Builder) UserRepos() *Builder {
 
 // CreatedPullRequests retrieves the number pull requests in a repo that have been created during a specific time period.
 func (b *Builder) CreatedPullRequests() *Builder {
-	pullRequestsCmd := prmetrics.NewCmd(b.cfg, b.pullRequestsService, b.repositoryService, b.tablePrinter, b.utils)
+	pullRequestsCmd := prmetrics.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.repositoryService, b.teamsService, b.automationService, b.categoriesService, b.codeOwnersService, b.tablePrinter, b.utils)
 	b.commands = append(b.commands, pullRequestsCmd)
 
 	return b
@@ -108,7 +212,7 @@ func (b *Builder) CreatedPullRequests() *Builder {
 
 // PullRequests retrieves the pull requests that the authenticated user has in a specific repo.
 func (b *Builder) PullRequests() *Builder {
-	pullRequestsCmd := pullrequests.NewCmd(b.cfg, b.pullRequestsService, b.tablePrinter, b.utils)
+	pullRequestsCmd := pullrequests.NewCmd(b.cfg, b.pullRequestsService, b.teamsService, b.tablePrinter, b.utils)
 	b.commands = append(b.commands, pullRequestsCmd)
 
 	return b
@@ -117,7 +221,7 @@ func (b *Builder) PullRequests() *Builder {
 // Find retrieves the repositories a user has access to and then allows the user to select multiple repos to retrieve
 // the pull requests that are open against the selected repositories.
 func (b *Builder) Find() *Builder {
-	findCmd := find.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.tablePrinter, b.utils)
+	findCmd := find.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.teamsService, b.tablePrinter, b.utils)
 	b.commands = append(b.commands, findCmd)
 
 	return b
@@ -125,7 +229,7 @@ func (b *Builder) Find() *Builder {
 
 // Widget is used to display all the details in widgets in terminal.
 func (b *Builder) Widget() *Builder {
-	widgetCmd := widget.NewCmd(b.cfg, b.userReposService, b.pullRequestsService)
+	widgetCmd := widget.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.teamsService)
 	b.commands = append(b.commands, widgetCmd)
 
 	return b
@@ -133,7 +237,7 @@ func (b *Builder) Widget() *Builder {
 
 // CommitList is used to retrieve and print a list of all the commits between 2 tags or commits.
 func (b *Builder) CommitList() *Builder {
-	commitListCmd := commitlist.NewCmd(b.cfg, b.repositoryService)
+	commitListCmd := commitlist.NewCmd(b.cfg, b.repositoryService, b.releaseNotesService)
 	b.commands = append(b.commands, commitListCmd)
 
 	return b
@@ -141,15 +245,31 @@ func (b *Builder) CommitList() *Builder {
 
 // CreateRelease is used to create a new release tag using the provided tag value and also define the description of the new release.
 func (b *Builder) CreateRelease() *Builder {
-	createReleaseCmd := createrelease.NewCmd(b.cfg, b.repositoryService)
+	createReleaseCmd := createrelease.NewCmd(b.cfg, b.repositoryService, b.pullRequestsService, b.releaseNotesService, b.utils)
 	b.commands = append(b.commands, createReleaseCmd)
 
 	return b
 }
 
+// Changelog is used to generate the changelog sections of releases and insert them in a changelog file.
+func (b *Builder) Changelog() *Builder {
+	changelogCmd := changelog.NewCmd(b.cfg, b.repositoryService)
+	b.commands = append(b.commands, changelogCmd)
+
+	return b
+}
+
+// Release is used to list, edit, publish and delete the releases of a repository.
+func (b *Builder) Release() *Builder {
+	releaseCmd := release.NewCmd(b.cfg, b.repositoryService, b.tablePrinter, b.utils)
+	b.commands = append(b.commands, releaseCmd)
+
+	return b
+}
+
 // ReleaseReport is used to fetch the releases for a desired period and based on the provided pattern to prepare reports.
 func (b *Builder) ReleaseReport() *Builder {
-	releaseReportCmd := releasereport.NewCmd(b.cfg, b.repositoryService, b.tablePrinter)
+	releaseReportCmd := releasereport.NewCmd(b.cfg, b.userReposService, b.repositoryService, b.tablePrinter, b.utils)
 	b.commands = append(b.commands, releaseReportCmd)
 
 	return b
@@ -157,7 +277,7 @@ func (b *Builder) ReleaseReport() *Builder {
 
 // PublishPullRequestMetrics retrieves the metrics for pull requests and publishes them to google spreadsheets.
 func (b *Builder) PublishPullRequestMetrics() *Builder {
-	publishMetricsCmd := publishmetrics.NewCmd(b.cfg, b.pullRequestsService, b.repositoryService, b.utils)
+	publishMetricsCmd := publishmetrics.NewCmd(b.cfg, b.pullRequestsService, b.repositoryService, b.teamsService, b.automationService, b.categoriesService, b.utils)
 	b.commands = append(b.commands, publishMetricsCmd)
 
 	return b
@@ -170,3 +290,67 @@ func (b *Builder) Workflows() *Builder {
 
 	return b
 }
+
+// Aging retrieves the open pull requests of repositories and reports them based on their age and inactivity.
+func (b *Builder) Aging() *Builder {
+	agingCmd := aging.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.tablePrinter, b.utils)
+	b.commands = append(b.commands, agingCmd)
+
+	return b
+}
+
+// CycleTime retrieves the pull requests of a repository and reports the time spent in each stage of their lifecycle.
+func (b *Builder) CycleTime() *Builder {
+	cycleTimeCmd := cycletime.NewCmd(b.cfg, b.pullRequestsService, b.teamsService, b.tablePrinter, b.utils)
+	b.commands = append(b.commands, cycleTimeCmd)
+
+	return b
+}
+
+// Hotspots retrieves the commits of a repository and reports the most frequently modified files and directories.
+func (b *Builder) Hotspots() *Builder {
+	hotspotsCmd := hotspots.NewCmd(b.cfg, b.repositoryService, b.codeOwnersService, b.tablePrinter)
+	b.commands = append(b.commands, hotspotsCmd)
+
+	return b
+}
+
+// CodeOwners provides the actions related to the CODEOWNERS file of a repository.
+func (b *Builder) CodeOwners() *Builder {
+	codeOwnersCmd := codeowners.NewCmd(b.cfg, b.pullRequestsService, b.codeOwnersService, b.tablePrinter)
+	b.commands = append(b.commands, codeOwnersCmd)
+
+	return b
+}
+
+// Knowledge retrieves the recent commits of a repository and reports the directories that depend on a few contributors.
+func (b *Builder) Knowledge() *Builder {
+	knowledgeCmd := knowledge.NewCmd(b.cfg, b.repositoryService, b.automationService, b.tablePrinter)
+	b.commands = append(b.commands, knowledgeCmd)
+
+	return b
+}
+
+// ReviewGraph retrieves the pull requests of repositories and reports which reviewers review the pull requests of each author.
+func (b *Builder) ReviewGraph() *Builder {
+	reviewGraphCmd := reviewgraph.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.automationService, b.tablePrinter)
+	b.commands = append(b.commands, reviewGraphCmd)
+
+	return b
+}
+
+// SuggestReviewers ranks the candidate reviewers of a pull request and optionally requests the review from the top ones.
+func (b *Builder) SuggestReviewers() *Builder {
+	suggestReviewersCmd := suggestreviewers.NewCmd(b.cfg, b.pullRequestsService, b.repositoryService, b.teamsService, b.automationService, b.codeOwnersService, b.tablePrinter)
+	b.commands = append(b.commands, suggestReviewersCmd)
+
+	return b
+}
+
+// Inbox lists the open pull requests of all the accessible repositories that wait for an action of the authenticated user.
+func (b *Builder) Inbox() *Builder {
+	inboxCmd := inbox.NewCmd(b.cfg, b.userReposService, b.pullRequestsService, b.automationService, b.tablePrinter, b.utils)
+	b.commands = append(b.commands, inboxCmd)
+
+	return b
+}
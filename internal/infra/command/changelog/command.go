@@ -0,0 +1,271 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	cl "github.com/eujoy/gitpr/pkg/changelog"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	defaultPageSize = 100
+	headReference   = "HEAD"
+)
+
+type service interface {
+	CreatePullRequestWithFile(authToken, repoOwner, repository, baseBranch, headBranch, path, content, sha, message, title, body string) (domain.PullRequest, error)
+	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
+	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
+	GetFileContent(authToken, repoOwner, repository, path, ref string) (string, string, error)
+	GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error)
+}
+
+// release describes the section of a release to be inserted in the changelog.
+type release struct {
+	tag     string
+	section string
+}
+
+// NewCmd creates a new command to generate the changelog sections of releases and insert them in a changelog file.
+func NewCmd(cfg config.Config, service service) *cli.Command {
+	var authToken, repoOwner, repository, startTag, endTag, releaseTag, changelogFile, baseBranch, prBranch string
+	var remote, allTags, dryRun bool
+
+	flagBuilder := flag.New(cfg)
+
+	changelogCmd := cli.Command{
+		Name:    "changelog",
+		Aliases: []string{"cl"},
+		Usage:   "Generates the changelog section of the changes between two tags and inserts it in a Keep a Changelog file, either locally or through a pull request.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendStartTagFlag(&startTag, false).
+			AppendEndTagFlag(&endTag, false).
+			AppendReleaseTagFlag(&releaseTag).
+			AppendChangelogFileFlag(&changelogFile).
+			AppendRemoteFlag(&remote).
+			AppendBaseFlag(&baseBranch).
+			AppendPullRequestBranchFlag(&prBranch).
+			AppendAllTagsFlag(&allTags).
+			AppendDryRunFlag(&dryRun).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			if !allTags && startTag == "" {
+				err := errors.New("the start tag is required, unless the sections of all the tags are regenerated")
+				fmt.Println(err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			repositoryUrl := fmt.Sprintf("https://github.com/%v/%v", repoOwner, repository)
+
+			var releases []release
+			var err error
+			if allTags {
+				releases, err = getAllReleases(authToken, repoOwner, repository, service, &repositoryUrl)
+			} else {
+				releases, err = getRelease(authToken, repoOwner, repository, startTag, endTag, releaseTag, service, &repositoryUrl)
+			}
+
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			if len(releases) == 0 {
+				spinLoader.Stop()
+				err = errors.New("no tags that are semantic versions were found")
+				fmt.Println(err)
+				return err
+			}
+
+			latestTag := releases[len(releases)-1].tag
+
+			var content, sha string
+			if remote {
+				content, sha, err = service.GetFileContent(authToken, repoOwner, repository, changelogFile, baseBranch)
+			} else {
+				content, err = readLocalFile(changelogFile)
+			}
+
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Printf("Failed to read %q with error : %v\n", changelogFile, err)
+				return err
+			}
+
+			for _, r := range releases {
+				content = cl.InsertKeepAChangelogSection(content, r.tag, r.section, repositoryUrl)
+			}
+
+			if dryRun {
+				spinLoader.Stop()
+				fmt.Println(content)
+				return nil
+			}
+
+			if !remote {
+				err = ioutil.WriteFile(changelogFile, []byte(content), 0644)
+				spinLoader.Stop()
+				if err != nil {
+					fmt.Printf("Failed to write %q with error : %v\n", changelogFile, err)
+					return err
+				}
+
+				fmt.Printf("Updated %v with the changes of %v\n", changelogFile, latestTag)
+				return nil
+			}
+
+			// The default branch is unique, so that the command can run again without conflicting with the branch of
+			// a previous run.
+			if prBranch == "" {
+				prBranch = fmt.Sprintf("changelog/%v-%v", latestTag, time.Now().Unix())
+			}
+
+			message := fmt.Sprintf("Update changelog for %v", latestTag)
+			body := fmt.Sprintf("Updates `%v` with the changes of %v.", changelogFile, latestTag)
+
+			pullRequest, err := service.CreatePullRequestWithFile(authToken, repoOwner, repository, baseBranch, prBranch, changelogFile, content, sha, message, message, body)
+			spinLoader.Stop()
+			if err != nil {
+				fmt.Printf("Failed to create the pull request with error : %v\n", err)
+				return err
+			}
+
+			fmt.Printf("Created pull request #%v : %v\n", pullRequest.Number, pullRequest.HtmlUrl)
+
+			return nil
+		},
+	}
+
+	return &changelogCmd
+}
+
+// getRelease generates the changelog section of the changes between the start and the end tag. In case no release tag
+// is provided, the end tag is used or, in case the end tag is HEAD, the next version is suggested based on the commits.
+func getRelease(authToken, repoOwner, repository, startTag, endTag, releaseTag string, service service, repositoryUrl *string) ([]release, error) {
+	diff, err := service.GetDiffBetweenTags(authToken, repoOwner, repository, startTag, endTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if releaseTag == "" {
+		releaseTag = endTag
+	}
+
+	if releaseTag == headReference {
+		commits := []domain.ConventionalCommit{}
+		for _, c := range diff.Commits {
+			commits = append(commits, cl.ParseCommitMessage(c.Details.Message))
+		}
+
+		releaseTag, _, err = cl.SuggestNextVersion(startTag, commits, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to suggest the release tag, please provide one : %v", err)
+		}
+	}
+
+	date := time.Now()
+	if endTag != headReference && len(diff.Commits) > 0 {
+		date = diff.Commits[len(diff.Commits)-1].Details.Committer.Date
+	}
+
+	setRepositoryUrl(repositoryUrl, diff.Commits)
+
+	return []release{{tag: releaseTag, section: cl.RenderKeepAChangelogSection(releaseTag, date, diff.Commits)}}, nil
+}
+
+// getAllReleases generates the changelog sections of all the tags of the repository that are semantic versions, from
+// the lowest to the highest version. The section of the lowest version has no changes, as there is no tag to compare
+// it with.
+func getAllReleases(authToken, repoOwner, repository string, service service, repositoryUrl *string) ([]release, error) {
+	var tags []domain.Tag
+	for pageNumber := 1; ; pageNumber++ {
+		tagList, err := service.GetTagList(authToken, repoOwner, repository, defaultPageSize, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tagList...)
+		if len(tagList) < defaultPageSize {
+			break
+		}
+	}
+
+	versionTags := cl.SortVersionTags(tags)
+	if len(versionTags) == 0 {
+		return nil, nil
+	}
+
+	commitShas := make(map[string]string)
+	for _, t := range tags {
+		commitShas[t.Name] = t.Commit.Sha
+	}
+
+	firstCommit, err := service.GetCommitDetails(authToken, repoOwner, repository, commitShas[versionTags[0]])
+	if err != nil {
+		return nil, err
+	}
+
+	releases := []release{{tag: versionTags[0], section: cl.RenderKeepAChangelogSection(versionTags[0], firstCommit.Details.Committer.Date, nil)}}
+
+	for idx := 1; idx < len(versionTags); idx++ {
+		diff, err := service.GetDiffBetweenTags(authToken, repoOwner, repository, versionTags[idx-1], versionTags[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		var date time.Time
+		if len(diff.Commits) > 0 {
+			date = diff.Commits[len(diff.Commits)-1].Details.Committer.Date
+		} else {
+			tagCommit, err := service.GetCommitDetails(authToken, repoOwner, repository, commitShas[versionTags[idx]])
+			if err != nil {
+				return nil, err
+			}
+
+			date = tagCommit.Details.Committer.Date
+		}
+
+		setRepositoryUrl(repositoryUrl, diff.Commits)
+
+		releases = append(releases, release{tag: versionTags[idx], section: cl.RenderKeepAChangelogSection(versionTags[idx], date, diff.Commits)})
+	}
+
+	return releases, nil
+}
+
+// setRepositoryUrl sets the url of the repository based on the url of the commits, which takes care of the github
+// enterprise installations as well.
+func setRepositoryUrl(repositoryUrl *string, commitList []domain.Commit) {
+	for _, c := range commitList {
+		if idx := strings.Index(c.HtmlUrl, "/commit/"); idx >= 0 {
+			*repositoryUrl = c.HtmlUrl[:idx]
+			return
+		}
+	}
+}
+
+// readLocalFile reads the content of a local file. A file that does not exist is considered to be empty.
+func readLocalFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+
+	return string(content), err
+}
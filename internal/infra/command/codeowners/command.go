@@ -0,0 +1,178 @@
+package codeowners
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/urfave/cli/v2"
+)
+
+type pullRequestService interface {
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error)
+	SearchPullRequests(authToken, query string) ([]domain.SearchIssue, error)
+}
+
+type codeOwnersService interface {
+	GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error)
+	GetFileOwners(codeOwners domain.CodeOwners, filename string) []string
+	GetRepositoryFiles(authToken, repoOwner, repository, ref string) ([]string, error)
+	GetUnapprovedFiles(authToken, repoOwner, repository string, pullRequestNumber int, codeOwners domain.CodeOwners, files []domain.CommitFile) ([]string, []string, error)
+}
+
+type tablePrinter interface {
+	PrintCodeOwnersReport(codeOwnersReport domain.CodeOwnersReport)
+}
+
+// NewCmd creates a new command to work with the code owners of a repository.
+func NewCmd(cfg config.Config, pullRequestService pullRequestService, codeOwnersService codeOwnersService, tablePrinter tablePrinter) *cli.Command {
+	codeOwnersCmd := cli.Command{
+		Name:    "codeowners",
+		Aliases: []string{"co"},
+		Usage:   "Provides the actions related to the CODEOWNERS file of a repository.",
+		Subcommands: []*cli.Command{
+			newCheckCmd(cfg, pullRequestService, codeOwnersService, tablePrinter),
+		},
+	}
+
+	return &codeOwnersCmd
+}
+
+// newCheckCmd creates the command to check the files without owners and the pull requests that got merged without
+// the approval of an owner.
+func newCheckCmd(cfg config.Config, pullRequestService pullRequestService, codeOwnersService codeOwnersService, tablePrinter tablePrinter) *cli.Command {
+	var authToken, repoOwner, repository, baseBranch string
+	var startDateStr, endDateStr string
+	var printJson bool
+
+	flagBuilder := flag.New(cfg)
+
+	checkCmd := cli.Command{
+		Name:  "check",
+		Usage: "Lists the files of a repository that do not have an owner and, in case a time period is provided, the pull requests merged during it without the approval of an owner.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendBaseFlag(&baseBranch).
+			AppendStartDateFlag(&startDateStr, false).
+			AppendEndDateFlag(&endDateStr, false).
+			AppendPrintJsonFlag(&printJson).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			codeOwners, err := codeOwnersService.GetCodeOwners(authToken, repoOwner, repository, baseBranch)
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			files, err := codeOwnersService.GetRepositoryFiles(authToken, repoOwner, repository, baseBranch)
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			codeOwnersReport := domain.CodeOwnersReport{
+				Path:         codeOwners.Path,
+				Files:        len(files),
+				UnownedFiles: []string{},
+				Violations:   []domain.CodeOwnersViolation{},
+			}
+
+			for _, f := range files {
+				if len(codeOwnersService.GetFileOwners(codeOwners, f)) == 0 {
+					codeOwnersReport.UnownedFiles = append(codeOwnersReport.UnownedFiles, f)
+				}
+			}
+
+			if startDateStr != "" && endDateStr != "" {
+				startDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 00:00:00", startDateStr))
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Printf("Failed to parse date %q with error : %v\n", startDateStr, err)
+					return err
+				}
+
+				endDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 23:59:59", endDateStr))
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Printf("Failed to parse date %q with error : %v\n", endDateStr, err)
+					return err
+				}
+
+				// The pull requests are searched by their merge date, since they may have been created before the period.
+				query := fmt.Sprintf("repo:%v/%v is:merged merged:%v..%v", repoOwner, repository, startDateStr, endDateStr)
+				if baseBranch != "" {
+					query = fmt.Sprintf("%v base:%v", query, baseBranch)
+				}
+
+				pullRequests, err := pullRequestService.SearchPullRequests(authToken, query)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				for _, pr := range pullRequests {
+					if pr.PullRequest.MergedAt.Before(startDate) || pr.PullRequest.MergedAt.After(endDate) {
+						continue
+					}
+
+					prFiles, err := pullRequestService.GetPullRequestFiles(authToken, repoOwner, repository, pr.Number)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Printf("Failed to get the files of pull request #%v with error : %v\n", pr.Number, err)
+						return err
+					}
+
+					unapprovedFiles, missingOwners, err := codeOwnersService.GetUnapprovedFiles(authToken, repoOwner, repository, pr.Number, codeOwners, prFiles)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Printf("Failed to check the approvals of pull request #%v with error : %v\n", pr.Number, err)
+						return err
+					}
+
+					codeOwnersReport.PullRequests++
+					if len(unapprovedFiles) > 0 {
+						codeOwnersReport.Violations = append(codeOwnersReport.Violations, domain.CodeOwnersViolation{
+							Number:          pr.Number,
+							Title:           pr.Title,
+							HtmlUrl:         pr.HtmlUrl,
+							Author:          pr.Creator.Username,
+							MergedAt:        pr.PullRequest.MergedAt,
+							UnapprovedFiles: unapprovedFiles,
+							MissingOwners:   missingOwners,
+						})
+					}
+				}
+			}
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(codeOwnersReport)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else {
+				tablePrinter.PrintCodeOwnersReport(codeOwnersReport)
+			}
+
+			return nil
+		},
+	}
+
+	return &checkCmd
+}
@@ -12,12 +12,19 @@ import (
 
 type service interface {
 	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
+	GetTemplateNames() []string
 	PrintCommitList(commitList []domain.Commit, useTmpl string) (string, error)
 }
 
+type releaseNotesService interface {
+	BuildReleaseNotes(authToken, repoOwner, repository, previousTag, tag string, commitList []domain.Commit) (domain.ReleaseNotes, error)
+	PrintReleaseNotes(releaseNotes domain.ReleaseNotes, templateFile string) (string, error)
+}
+
 // NewCmd creates a new command to retrieve the commits between 2 provided tags or commits.
-func NewCmd(cfg config.Config, service service) *cli.Command {
-	var authToken, repoOwner, repository, startTag, endTag string
+func NewCmd(cfg config.Config, service service, releaseNotesService releaseNotesService) *cli.Command {
+	var authToken, repoOwner, repository, startTag, endTag, templateFile, templateName string
+	var useChangelog, useReleaseNotes bool
 
 	flagBuilder := flag.New(cfg)
 
@@ -27,19 +34,55 @@ func NewCmd(cfg config.Config, service service) *cli.Command {
 		Usage:   "Retrieves and prints the list of commits between two provided tags or commits.",
 		Flags: flagBuilder.
 			AppendAuthFlag(&authToken).
-			AppendOwnerFlag(&repoOwner).
+			AppendOwnerFlag(&repoOwner, true).
 			AppendRepositoryFlag(&repository).
-			AppendStartTagFlag(&startTag).
-			AppendEndTagFlag(&endTag).
+			AppendStartTagFlag(&startTag, true).
+			AppendEndTagFlag(&endTag, true).
+			AppendChangelogFlag(&useChangelog).
+			AppendTemplateFlag(&templateName, service.GetTemplateNames()).
+			AppendReleaseNotesFlag(&useReleaseNotes).
+			AppendTemplateFileFlag(&templateFile).
 			GetFlags(),
 		Action: func(c *cli.Context) error {
+			if useChangelog && useReleaseNotes {
+				fmt.Println("The changelog flag cannot be provided along with the release notes flag, as only one of them can be printed.")
+				os.Exit(1)
+			}
+
 			commitList, err := service.GetDiffBetweenTags(authToken, repoOwner, repository, startTag, endTag)
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
 			}
 
-			commitListPrintout, err := service.PrintCommitList(commitList.Commits, domain.CommitListTerminalTemplate)
+			if useReleaseNotes {
+				releaseNotes, err := releaseNotesService.BuildReleaseNotes(authToken, repoOwner, repository, startTag, endTag, commitList.Commits)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+
+				releaseNotesPrintout, err := releaseNotesService.PrintReleaseNotes(releaseNotes, templateFile)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+
+				fmt.Println(releaseNotesPrintout)
+
+				return nil
+			}
+
+			useTmpl := domain.CommitListTerminalTemplate
+			if useChangelog {
+				useTmpl = domain.CommitListChangelogTemplate
+			}
+
+			if templateName != "" {
+				useTmpl = templateName
+			}
+
+			commitListPrintout, err := service.PrintCommitList(commitList.Commits, useTmpl)
 			if err != nil {
 				os.Exit(1)
 			}
@@ -3,15 +3,23 @@ package createrelease
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/eujoy/gitpr/internal/config"
 	"github.com/eujoy/gitpr/internal/domain"
 	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/changelog"
+	"github.com/eujoy/gitpr/pkg/utils"
 	"github.com/urfave/cli/v2"
 )
 
+const (
+	defaultPageSize = 100
+	// initialServiceVersion is the version that the first release of a service is suggested from.
+	initialServiceVersion = "v0.0.0"
+)
+
 var promptMessages = map[bool]string{
 	true:  "Are you sure you want to create the draft release?",
 	false: "Are you sure you want to create the release?",
@@ -21,14 +29,44 @@ type service interface {
 	CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error
 	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
 	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
+	GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error)
+	GetTemplateNames() []string
 	PrintCommitList(commitList []domain.Commit, useTmpl string) (string, error)
 }
 
+type pullRequestService interface {
+	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+}
+
+type releaseNotesService interface {
+	BuildReleaseNotes(authToken, repoOwner, repository, previousTag, tag string, commitList []domain.Commit) (domain.ReleaseNotes, error)
+	PrintReleaseNotes(releaseNotes domain.ReleaseNotes, templateFile string) (string, error)
+}
+
+type utilities interface {
+	Confirm(force bool, message string) (bool, error)
+	RunConcurrently(count int, fn func(idx int) error) error
+}
+
+// releaseOptions describes the repository and the options that the releases are created with.
+type releaseOptions struct {
+	authToken       string
+	repoOwner       string
+	repository      string
+	releaseName     string
+	templateName    string
+	templateFile    string
+	draftRelease    bool
+	useChangelog    bool
+	useReleaseNotes bool
+	forceCreate     bool
+}
+
 // NewCmd creates a new command to retrieve the commits between 2 provided tags or commits.
-func NewCmd(cfg config.Config, service service) *cli.Command {
-	var authToken, repoOwner, repository, latestTag, releaseTag, releaseName string
-	var draftRelease bool
-	var checkPattern cli.StringSlice
+func NewCmd(cfg config.Config, service service, pullRequestService pullRequestService, releaseNotesService releaseNotesService, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, latestTag, releaseTag, releaseName, templateFile, templateName string
+	var draftRelease, useChangelog, useReleaseNotes, services bool
+	var checkPattern, excludePattern cli.StringSlice
 
 	forceCreate := false
 
@@ -40,81 +78,101 @@ func NewCmd(cfg config.Config, service service) *cli.Command {
 		Usage:   "Retrieves all the commits between two tags and creates a list of them to be used a release description..",
 		Flags: flagBuilder.
 			AppendAuthFlag(&authToken).
-			AppendOwnerFlag(&repoOwner).
+			AppendOwnerFlag(&repoOwner, true).
 			AppendRepositoryFlag(&repository).
 			AppendReleaseNameFlag(&releaseName).
 			AppendLatestTagFlag(&latestTag).
 			AppendReleaseTagFlag(&releaseTag).
 			AppendCheckPatternFlag(&checkPattern).
+			AppendExcludePatternFlag(&excludePattern).
+			AppendServicesFlag(&services).
 			AppendDraftReleaseFlag(&draftRelease).
+			AppendChangelogFlag(&useChangelog).
+			AppendTemplateFlag(&templateName, service.GetTemplateNames()).
+			AppendReleaseNotesFlag(&useReleaseNotes).
+			AppendTemplateFileFlag(&templateFile).
 			AppendForceCreateFlag(&forceCreate).
 			GetFlags(),
 		Action: func(c *cli.Context) error {
-			commitList, err := service.GetDiffBetweenTags(authToken, repoOwner, repository, latestTag, "HEAD")
+			if useChangelog && useReleaseNotes {
+				fmt.Println("The changelog flag cannot be provided along with the release notes flag, as only one of them can be the description of the release.")
+				os.Exit(1)
+			}
+
+			pathFilter, err := utils.NewPathFilter(checkPattern.Value(), excludePattern.Value())
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
 			}
 
-			listOfCommitsToPrint := commitList.Commits
-			checkPatternValues := checkPattern.Value()
-			if len(checkPatternValues) > 0 {
-				includeCommits := make(map[string]domain.Commit)
-
-				for _, commitItem := range commitList.Commits {
-					commitDetails, err := service.GetCommitDetails(authToken, repoOwner, repository, commitItem.Sha)
-					if err != nil {
-						fmt.Println(err)
-						os.Exit(1)
-					}
-
-					for _, fileInCommit := range commitDetails.Files {
-						for _, pattern := range checkPatternValues {
-							if strings.Contains(fileInCommit.Filename, pattern) {
-								includeCommits[commitItem.Sha] = commitItem
-								break
-							}
-						}
-					}
-
-					listOfCommitsToPrint = []domain.Commit{}
-					for _, cmt := range includeCommits {
-						listOfCommitsToPrint = append(listOfCommitsToPrint, cmt)
-					}
-				}
+			options := releaseOptions{
+				authToken:       authToken,
+				repoOwner:       repoOwner,
+				repository:      repository,
+				releaseName:     releaseName,
+				templateName:    templateName,
+				templateFile:    templateFile,
+				draftRelease:    draftRelease,
+				useChangelog:    useChangelog,
+				useReleaseNotes: useReleaseNotes,
+				forceCreate:     forceCreate,
 			}
 
-			commitListPrintout, err := service.PrintCommitList(listOfCommitsToPrint, domain.CommitListReleaseTemplate)
-			if err != nil {
-				os.Exit(1)
-			}
+			commitFiles := make(map[string][]string)
 
-			releaseName = fmt.Sprintf(releaseName, releaseTag)
+			if services {
+				if releaseTag != "" {
+					fmt.Println("The release tag cannot be provided along with the services flag, as the tag of each service is suggested.")
+					os.Exit(1)
+				}
 
-			fmt.Println(releaseName)
-			fmt.Println(commitListPrintout)
+				if len(cfg.Services) == 0 {
+					fmt.Println("No services are defined in the configuration.")
+					os.Exit(1)
+				}
 
-			createRelease := false
-			if !forceCreate {
-				createReleasePrompt := &survey.Confirm{
-					Message: promptMessages[draftRelease],
+				err = createServiceReleases(options, latestTag, cfg.Services, pathFilter, commitFiles, service, pullRequestService, releaseNotesService, utilities)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
 				}
 
-				err = survey.AskOne(createReleasePrompt, &createRelease)
+				return nil
+			}
+
+			commitList, err := service.GetDiffBetweenTags(authToken, repoOwner, repository, latestTag, "HEAD")
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			listOfCommitsToPrint := commitList.Commits
+			if !pathFilter.IsEmpty() {
+				listOfCommitsToPrint, err = filterCommits(options, commitList.Commits, pathFilter.Matches, commitFiles, service, utilities)
 				if err != nil {
 					fmt.Println(err)
 					os.Exit(1)
 				}
 			}
 
-			if forceCreate || createRelease {
-				err = service.CreateRelease(authToken, repoOwner, repository, releaseTag, draftRelease, releaseName, commitListPrintout)
+			if releaseTag != "" {
+				err = validateReleaseTag(latestTag, releaseTag)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+			} else {
+				releaseTag, err = suggestReleaseTag(authToken, repoOwner, repository, latestTag, listOfCommitsToPrint, pullRequestService)
 				if err != nil {
-					fmt.Printf("Failed to create release with error : %v\n", err)
+					fmt.Printf("Failed to suggest the release tag with error : %v\n", err)
 					os.Exit(1)
 				}
+			}
 
-				fmt.Printf("Created release: '%v' \n", releaseName)
+			err = createRelease(options, latestTag, releaseTag, listOfCommitsToPrint, service, releaseNotesService, utilities)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
 			}
 
 			return nil
@@ -123,3 +181,256 @@ func NewCmd(cfg config.Config, service service) *cli.Command {
 
 	return &commitListCmd
 }
+
+// createServiceReleases creates one release for each of the services that has changes since its latest release tag,
+// which is the highest version among the tags with the tag prefix of the service. The changes of a service are the
+// commits that modified files under its directory, which match the path filter as well. A service without any release
+// tag is compared with the latest tag of the repository and gets its first version based on its changes.
+func createServiceReleases(options releaseOptions, latestTag string, services map[string]string, pathFilter *utils.PathFilter, commitFiles map[string][]string, service service, pullRequestService pullRequestService, releaseNotesService releaseNotesService, utilities utilities) error {
+	tags, err := getTags(options, service)
+	if err != nil {
+		return err
+	}
+
+	servicePaths := make([]string, 0, len(services))
+	for p := range services {
+		servicePaths = append(servicePaths, p)
+	}
+	sort.Strings(servicePaths)
+
+	for _, servicePath := range servicePaths {
+		tagPrefix := services[servicePath]
+
+		baseTag, previousTag := latestTag, getLatestServiceTag(tags, tagPrefix)
+		if previousTag != "" {
+			baseTag = previousTag
+		} else {
+			previousTag = tagPrefix + initialServiceVersion
+		}
+
+		diff, err := service.GetDiffBetweenTags(options.authToken, options.repoOwner, options.repository, baseTag, "HEAD")
+		if err != nil {
+			return err
+		}
+
+		directory := strings.TrimSuffix(servicePath, "/") + "/"
+		commitList, err := filterCommits(options, diff.Commits, func(filename string) bool {
+			return strings.HasPrefix(filename, directory) && pathFilter.Matches(filename)
+		}, commitFiles, service, utilities)
+		if err != nil {
+			return err
+		}
+
+		if len(commitList) == 0 {
+			fmt.Printf("No changes in %v since %v\n", servicePath, baseTag)
+			continue
+		}
+
+		fmt.Printf("Service : %v\n", servicePath)
+
+		// The version is suggested without the tag prefix, since the prefix may contain digits as well.
+		releaseVersion, err := suggestReleaseTag(options.authToken, options.repoOwner, options.repository, strings.TrimPrefix(previousTag, tagPrefix), commitList, pullRequestService)
+		if err != nil {
+			return fmt.Errorf("failed to suggest the release tag of %v with error : %v", servicePath, err)
+		}
+		releaseTag := tagPrefix + releaseVersion
+
+		err = createRelease(options, baseTag, releaseTag, commitList, service, releaseNotesService, utilities)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createRelease prints the name and the description of the release and creates it after the confirmation of the user,
+// unless it is forced.
+func createRelease(options releaseOptions, previousTag, releaseTag string, commitList []domain.Commit, service service, releaseNotesService releaseNotesService, utilities utilities) error {
+	var commitListPrintout string
+	if options.useReleaseNotes {
+		releaseNotes, err := releaseNotesService.BuildReleaseNotes(options.authToken, options.repoOwner, options.repository, previousTag, releaseTag, commitList)
+		if err != nil {
+			return err
+		}
+
+		commitListPrintout, err = releaseNotesService.PrintReleaseNotes(releaseNotes, options.templateFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		useTmpl := domain.CommitListReleaseTemplate
+		if options.useChangelog {
+			useTmpl = domain.CommitListChangelogTemplate
+		}
+
+		if options.templateName != "" {
+			useTmpl = options.templateName
+		}
+
+		var err error
+		commitListPrintout, err = service.PrintCommitList(commitList, useTmpl)
+		if err != nil {
+			return err
+		}
+	}
+
+	releaseName := fmt.Sprintf(options.releaseName, releaseTag)
+
+	fmt.Println(releaseName)
+	fmt.Println(commitListPrintout)
+
+	confirmed, err := utilities.Confirm(options.forceCreate, promptMessages[options.draftRelease])
+	if err != nil {
+		return err
+	}
+
+	if confirmed {
+		err = service.CreateRelease(options.authToken, options.repoOwner, options.repository, releaseTag, options.draftRelease, releaseName, commitListPrintout)
+		if err != nil {
+			return fmt.Errorf("failed to create release with error : %v", err)
+		}
+
+		fmt.Printf("Created release: '%v' \n", releaseName)
+	}
+
+	return nil
+}
+
+// filterCommits keeps the commits that modified any file that matches, in the order they are provided. The files of
+// the commits are retrieved concurrently and kept, so that the details of each commit are only retrieved once.
+func filterCommits(options releaseOptions, commitList []domain.Commit, matches func(filename string) bool, commitFiles map[string][]string, service service, utilities utilities) ([]domain.Commit, error) {
+	var missingShas []string
+	for _, c := range commitList {
+		if _, ok := commitFiles[c.Sha]; !ok {
+			missingShas = append(missingShas, c.Sha)
+		}
+	}
+
+	missingFiles := make([][]string, len(missingShas))
+	err := utilities.RunConcurrently(len(missingShas), func(idx int) error {
+		commitDetails, err := service.GetCommitDetails(options.authToken, options.repoOwner, options.repository, missingShas[idx])
+		if err != nil {
+			return err
+		}
+
+		missingFiles[idx] = []string{}
+		for _, f := range commitDetails.Files {
+			missingFiles[idx] = append(missingFiles[idx], f.Filename)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, sha := range missingShas {
+		commitFiles[sha] = missingFiles[idx]
+	}
+
+	filteredCommits := []domain.Commit{}
+	for _, c := range commitList {
+		for _, f := range commitFiles[c.Sha] {
+			if matches(f) {
+				filteredCommits = append(filteredCommits, c)
+				break
+			}
+		}
+	}
+
+	return filteredCommits, nil
+}
+
+// getTags retrieves all the tags of the repository.
+func getTags(options releaseOptions, service service) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for pageNumber := 1; ; pageNumber++ {
+		tagList, err := service.GetTagList(options.authToken, options.repoOwner, options.repository, defaultPageSize, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tagList...)
+		if len(tagList) < defaultPageSize {
+			return tags, nil
+		}
+	}
+}
+
+// getLatestServiceTag returns the tag with the highest version among the ones that consist of the tag prefix followed
+// by a semantic version, or an empty string in case there is none. The versions are compared without the tag prefix,
+// since the prefix may contain digits as well.
+func getLatestServiceTag(tags []domain.Tag, tagPrefix string) string {
+	var latestTag string
+	var latestVersion domain.SemanticVersion
+	for _, t := range tags {
+		if !strings.HasPrefix(t.Name, tagPrefix) {
+			continue
+		}
+
+		version, err := changelog.ParseSemanticVersion(strings.TrimPrefix(t.Name, tagPrefix))
+		if err != nil || (version.Prefix != "" && version.Prefix != "v") {
+			continue
+		}
+
+		if latestTag == "" || changelog.CompareSemanticVersions(version, latestVersion) > 0 {
+			latestTag, latestVersion = t.Name, version
+		}
+	}
+
+	return latestTag
+}
+
+// validateReleaseTag checks that the provided release tag is different than the latest tag and, in case both of them
+// are semantic versions, that it is a higher version.
+func validateReleaseTag(latestTag, releaseTag string) error {
+	if releaseTag == latestTag {
+		return fmt.Errorf("the release tag %q needs to be different than the latest tag", releaseTag)
+	}
+
+	latestVersion, latestErr := changelog.ParseSemanticVersion(latestTag)
+	releaseVersion, releaseErr := changelog.ParseSemanticVersion(releaseTag)
+	if latestErr == nil && releaseErr == nil && changelog.CompareSemanticVersions(releaseVersion, latestVersion) <= 0 {
+		return fmt.Errorf("the release tag %q needs to be a higher version than the latest tag %q", releaseTag, latestTag)
+	}
+
+	return nil
+}
+
+// suggestReleaseTag suggests the next release tag based on the conventional commit messages of the commits since the
+// latest tag and the labels of the pull requests they refer to.
+func suggestReleaseTag(authToken, repoOwner, repository, latestTag string, commitList []domain.Commit, pullRequestService pullRequestService) (string, error) {
+	commits := []domain.ConventionalCommit{}
+	labels := []string{}
+	checkedPullRequests := make(map[int]bool)
+
+	for _, c := range commitList {
+		commit := changelog.ParseCommitMessage(c.Details.Message)
+		commits = append(commits, commit)
+
+		if commit.PullRequestNumber == 0 || checkedPullRequests[commit.PullRequestNumber] {
+			continue
+		}
+
+		checkedPullRequests[commit.PullRequestNumber] = true
+
+		pullRequest, err := pullRequestService.GetPullRequestsDetails(authToken, repoOwner, repository, commit.PullRequestNumber)
+		if err != nil {
+			return "", err
+		}
+
+		for _, l := range pullRequest.Labels {
+			labels = append(labels, l.Name)
+		}
+	}
+
+	releaseTag, bump, err := changelog.SuggestNextVersion(latestTag, commits, labels)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Printf("Suggested release tag : %v (%v bump from %v)\n", releaseTag, bump, latestTag)
+
+	return releaseTag, nil
+}
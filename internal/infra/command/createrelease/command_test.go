@@ -0,0 +1,374 @@
+package createrelease
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/utils"
+)
+
+type fakePullRequestService struct {
+	pullRequests map[int]domain.PullRequest
+	err          error
+	requested    []int
+}
+
+func (s *fakePullRequestService) GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error) {
+	s.requested = append(s.requested, pullRequestNumber)
+	if s.err != nil {
+		return domain.PullRequest{}, s.err
+	}
+
+	return s.pullRequests[pullRequestNumber], nil
+}
+
+type fakeService struct {
+	tags             []domain.Tag
+	diffs            map[string][]domain.Commit
+	files            map[string][]string
+	err              error
+	requestedCommits []string
+	createdReleases  []string
+	releaseBodies    []string
+}
+
+func (s *fakeService) CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error {
+	s.createdReleases = append(s.createdReleases, tagName)
+	s.releaseBodies = append(s.releaseBodies, body)
+	return nil
+}
+
+func (s *fakeService) GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error) {
+	s.requestedCommits = append(s.requestedCommits, commitSha)
+	if s.err != nil {
+		return domain.Commit{}, s.err
+	}
+
+	commit := domain.Commit{Sha: commitSha}
+	for _, f := range s.files[commitSha] {
+		commit.Files = append(commit.Files, domain.CommitFile{Filename: f})
+	}
+
+	return commit, nil
+}
+
+func (s *fakeService) GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error) {
+	return domain.CompareTagsResponse{Commits: s.diffs[existingTag]}, nil
+}
+
+func (s *fakeService) GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error) {
+	if pageNumber > 1 {
+		return []domain.Tag{}, nil
+	}
+
+	return s.tags, nil
+}
+
+func (s *fakeService) GetTemplateNames() []string {
+	return []string{domain.CommitListReleaseTemplate}
+}
+
+func (s *fakeService) PrintCommitList(commitList []domain.Commit, useTmpl string) (string, error) {
+	var shas []string
+	for _, c := range commitList {
+		shas = append(shas, c.Sha)
+	}
+
+	return strings.Join(shas, ","), nil
+}
+
+type fakeReleaseNotesService struct{}
+
+func (s *fakeReleaseNotesService) BuildReleaseNotes(authToken, repoOwner, repository, previousTag, tag string, commitList []domain.Commit) (domain.ReleaseNotes, error) {
+	return domain.ReleaseNotes{}, nil
+}
+
+func (s *fakeReleaseNotesService) PrintReleaseNotes(releaseNotes domain.ReleaseNotes, templateFile string) (string, error) {
+	return "", nil
+}
+
+type fakeUtilities struct{}
+
+func (u *fakeUtilities) Confirm(force bool, message string) (bool, error) {
+	return true, nil
+}
+
+func (u *fakeUtilities) RunConcurrently(count int, fn func(idx int) error) error {
+	for idx := 0; idx < count; idx++ {
+		err := fn(idx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestCreateServiceReleases(t *testing.T) {
+	commit := func(sha, message string) domain.Commit {
+		return domain.Commit{Sha: sha, Details: domain.CommitDetails{Message: message}}
+	}
+
+	srv := &fakeService{
+		tags: []domain.Tag{
+			{Name: "api/v1.2.0"},
+			{Name: "api/v1.10.0"},
+			{Name: "s3/v0.3.0"},
+			{Name: "s3/v0.2.0"},
+			{Name: "v2.0.0"},
+		},
+		diffs: map[string][]domain.Commit{
+			"api/v1.10.0": {commit("a", "fix: handle nil"), commit("b", "docs: update readme")},
+			"s3/v0.3.0":   {commit("a", "fix: handle nil"), commit("c", "feat: add buckets")},
+			"v2.0.0":      {commit("d", "feat: first endpoint"), commit("e", "test: cover the endpoint")},
+		},
+		files: map[string][]string{
+			"a": {"services/api/main.go"},
+			"b": {"README.md"},
+			"c": {"services/s3/bucket.go"},
+			"d": {"services/new/main.go"},
+			"e": {"services/new/main_test.go"},
+		},
+	}
+
+	services := map[string]string{
+		"services/api":  "api/",
+		"services/new/": "new/",
+		"services/s3/":  "s3/",
+		"services/old/": "old/",
+	}
+
+	pathFilter, err := utils.NewPathFilter(nil, []string{"**/*_test.go"})
+	if err != nil {
+		t.Fatalf("Expected to get nil as error, but got '%v'", err)
+	}
+
+	options := releaseOptions{releaseName: "Release %v", forceCreate: true}
+	commitFiles := make(map[string][]string)
+
+	err = createServiceReleases(options, "v2.0.0", services, pathFilter, commitFiles, srv, &fakePullRequestService{}, &fakeReleaseNotesService{}, &fakeUtilities{})
+	if err != nil {
+		t.Errorf("Expected to get nil as error, but got '%v'", err)
+	}
+
+	expectedReleases := []string{"api/v1.10.1", "new/v0.1.0", "s3/v0.4.0"}
+	if !reflect.DeepEqual(expectedReleases, srv.createdReleases) {
+		t.Errorf("Expected to get '%v' as created releases, but got '%v'", expectedReleases, srv.createdReleases)
+	}
+
+	expectedBodies := []string{"a", "d", "c"}
+	if !reflect.DeepEqual(expectedBodies, srv.releaseBodies) {
+		t.Errorf("Expected to get '%v' as release bodies, but got '%v'", expectedBodies, srv.releaseBodies)
+	}
+
+	expectedRequestedCommits := []string{"a", "b", "d", "e", "c"}
+	if !reflect.DeepEqual(expectedRequestedCommits, srv.requestedCommits) {
+		t.Errorf("Expected to get '%v' as requested commits, but got '%v'", expectedRequestedCommits, srv.requestedCommits)
+	}
+}
+
+func TestFilterCommits(t *testing.T) {
+	commitList := []domain.Commit{{Sha: "a"}, {Sha: "b"}, {Sha: "c"}}
+	matches := func(filename string) bool {
+		return strings.HasPrefix(filename, "app/")
+	}
+
+	t.Run("Commits that modified any matching file", func(t *testing.T) {
+		srv := &fakeService{files: map[string][]string{
+			"a": {"docs/README.md", "app/main.go"},
+			"c": {"app/handler.go"},
+		}}
+		commitFiles := map[string][]string{"b": {"app/known.go"}}
+
+		actual, err := filterCommits(releaseOptions{}, commitList, matches, commitFiles, srv, &fakeUtilities{})
+
+		expected := []domain.Commit{{Sha: "a"}, {Sha: "b"}, {Sha: "c"}}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as commits, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+
+		expectedRequestedCommits := []string{"a", "c"}
+		if !reflect.DeepEqual(expectedRequestedCommits, srv.requestedCommits) {
+			t.Errorf("Expected to get '%v' as requested commits, but got '%v'", expectedRequestedCommits, srv.requestedCommits)
+		}
+
+		expectedCommitFiles := map[string][]string{
+			"a": {"docs/README.md", "app/main.go"},
+			"b": {"app/known.go"},
+			"c": {"app/handler.go"},
+		}
+		if !reflect.DeepEqual(expectedCommitFiles, commitFiles) {
+			t.Errorf("Expected to get '%v' as commit files, but got '%v'", expectedCommitFiles, commitFiles)
+		}
+	})
+
+	t.Run("No matching commits", func(t *testing.T) {
+		srv := &fakeService{files: map[string][]string{"a": {"docs/README.md"}}}
+
+		actual, err := filterCommits(releaseOptions{}, commitList, matches, make(map[string][]string), srv, &fakeUtilities{})
+
+		expected := []domain.Commit{}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as commits, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Failure to retrieve the details of a commit - expecting an error", func(t *testing.T) {
+		srv := &fakeService{err: errors.New("failure")}
+
+		_, err := filterCommits(releaseOptions{}, commitList, matches, make(map[string][]string), srv, &fakeUtilities{})
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestGetLatestServiceTag(t *testing.T) {
+	tags := []domain.Tag{
+		{Name: "api/v1.2.0"},
+		{Name: "api/v1.10.0"},
+		{Name: "api/v1.11.0-rc.1"},
+		{Name: "api/latest"},
+		{Name: "s3/v0.9.0"},
+		{Name: "s3/v0.10.0"},
+		{Name: "oauth2/2.1.0"},
+		{Name: "oauth2/release-3.0.0"},
+		{Name: "v3.0.0"},
+	}
+
+	testCases := map[string]struct {
+		tagPrefix string
+		expected  string
+	}{
+		"Highest version of the prefix": {
+			tagPrefix: "api/",
+			expected:  "api/v1.11.0-rc.1",
+		},
+		"Prefix with digits": {
+			tagPrefix: "s3/",
+			expected:  "s3/v0.10.0",
+		},
+		"Prefix with digits and a version without 'v'": {
+			tagPrefix: "oauth2/",
+			expected:  "oauth2/2.1.0",
+		},
+		"Prefix without any tag": {
+			tagPrefix: "billing/",
+			expected:  "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := getLatestServiceTag(tags, tc.tagPrefix)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as latest tag, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateReleaseTag(t *testing.T) {
+	testCases := map[string]struct {
+		latestTag     string
+		releaseTag    string
+		expectedError bool
+	}{
+		"Higher version": {
+			latestTag:  "v1.2.3",
+			releaseTag: "v1.3.0",
+		},
+		"Tags that are not versions": {
+			latestTag:  "release-2021-01",
+			releaseTag: "release-2021-02",
+		},
+		"Same tag - expecting an error": {
+			latestTag:     "v1.2.3",
+			releaseTag:    "v1.2.3",
+			expectedError: true,
+		},
+		"Lower version - expecting an error": {
+			latestTag:     "v1.2.3",
+			releaseTag:    "v1.2.0",
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateReleaseTag(tc.latestTag, tc.releaseTag)
+			if tc.expectedError != (err != nil) {
+				t.Errorf("Expected to get an error to be '%v', but got '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSuggestReleaseTag(t *testing.T) {
+	commitList := []domain.Commit{
+		{Details: domain.CommitDetails{Message: "fix: handle empty lists (#1)"}},
+		{Details: domain.CommitDetails{Message: "fix: handle nil maps (#2)"}},
+		{Details: domain.CommitDetails{Message: "Merge the fixes (#2)"}},
+		{Details: domain.CommitDetails{Message: "chore: update the dependencies"}},
+	}
+
+	testCases := map[string]struct {
+		latestTag    string
+		pullRequests map[int]domain.PullRequest
+		expected     string
+	}{
+		"Patch bump of the fixes": {
+			latestTag: "v1.2.3",
+			expected:  "v1.2.4",
+		},
+		"Minor bump of a pull request label": {
+			latestTag: "v1.2.3",
+			pullRequests: map[int]domain.PullRequest{
+				2: {Number: 2, Labels: []domain.Label{{Name: "minor"}}},
+			},
+			expected: "v1.3.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pullRequestService := &fakePullRequestService{pullRequests: tc.pullRequests}
+
+			actual, err := suggestReleaseTag("token", "o", "r", tc.latestTag, commitList, pullRequestService)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as release tag, but got '%v'", tc.expected, actual)
+			}
+			if err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+			if len(pullRequestService.requested) != 2 {
+				t.Errorf("Expected to get '%v' pull requests retrieved, but got '%v'", 2, pullRequestService.requested)
+			}
+		})
+	}
+
+	t.Run("Failure to retrieve a pull request - expecting an error", func(t *testing.T) {
+		pullRequestService := &fakePullRequestService{err: errors.New("failure")}
+
+		_, err := suggestReleaseTag("token", "o", "r", "v1.2.3", commitList, pullRequestService)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+
+	t.Run("Latest tag that is not a version - expecting an error", func(t *testing.T) {
+		_, err := suggestReleaseTag("token", "o", "r", "main", commitList, &fakePullRequestService{})
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
@@ -0,0 +1,171 @@
+package cycletime
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	defaultPageSize = 20
+)
+
+type pullRequestService interface {
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
+	GetPullRequestTimeline(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.TimelineEvent, error)
+	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+}
+
+type teamsService interface {
+	GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+	IsTeamPullRequest(pullRequest domain.PullRequest, members []string, teamRole string) bool
+}
+
+type tablePrinter interface {
+	PrintCycleTimeReport(cycleTimeReport domain.CycleTimeReport)
+}
+
+type utilities interface {
+	ConvertDurationToString(dur time.Duration) string
+	GetValidPullRequestState(prState string) string
+	ConvertCycleTimeStagesToString(stages domain.CycleTimeStages) domain.CycleTimeStages
+}
+
+// NewCmd creates a new command to report the time the pull requests of a repository spent in each stage of their lifecycle.
+func NewCmd(cfg config.Config, pullRequestService pullRequestService, teamsService teamsService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, baseBranch, prState, team, teamRole string
+	var startDateStr, endDateStr string
+	var printJson, includeBots bool
+
+	flagBuilder := flag.New(cfg)
+
+	cycleTimeCmd := cli.Command{
+		Name:    "cycle-time",
+		Aliases: []string{"ct"},
+		Usage:   "Retrieves the pull requests of a repository that have been created during a specific time period and splits their cycle time into coding, draft, waiting for review, in review and waiting to merge stages.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendBaseFlag(&baseBranch).
+			AppendStateFlag(&prState).
+			AppendStartDateFlag(&startDateStr, true).
+			AppendEndDateFlag(&endDateStr, true).
+			AppendPrintJsonFlag(&printJson).
+			AppendTeamFlag(&team).
+			AppendTeamRoleFlag(&teamRole).
+			AppendIncludeBotsFlag(&includeBots).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			teamMembers, err := teamsService.GetFilterMembers(authToken, team, teamRole)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			startDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 00:00:00", startDateStr))
+			if err != nil {
+				fmt.Printf("Failed to parse date %q with error : %v\n", startDateStr, err)
+				return err
+			}
+
+			endDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 23:59:59", endDateStr))
+			if err != nil {
+				fmt.Printf("Failed to parse date %q with error : %v\n", endDateStr, err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			cycleTimeReport := domain.CycleTimeReport{}
+			var stagesList []domain.CycleTimeStages
+
+			prState = utilities.GetValidPullRequestState(prState)
+			shallContinue := true
+			currentPage := 1
+			for shallContinue {
+				prResp, err := pullRequestService.GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState, defaultPageSize, currentPage)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				if len(prResp.PullRequests) == 0 {
+					break
+				}
+
+				pullRequests, _ := pullRequestService.FilterAutomatedPullRequests(prResp.PullRequests, includeBots)
+				for _, pr := range pullRequests {
+					if pr.CreatedAt.Before(startDate) {
+						shallContinue = false
+						continue
+					}
+
+					if pr.CreatedAt.After(endDate) || !teamsService.IsTeamPullRequest(pr, teamMembers, teamRole) {
+						continue
+					}
+
+					// Pull requests that have been closed without being merged never completed their cycle.
+					endAt := time.Now()
+					if !pr.MergedAt.IsZero() {
+						endAt = pr.MergedAt
+					} else if !pr.ClosedAt.IsZero() {
+						continue
+					}
+
+					timelineEvents, err := pullRequestService.GetPullRequestTimeline(authToken, repoOwner, repository, pr.Number)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Printf("Failed to get the timeline of pull request #%d with error : %v\n", pr.Number, err)
+						return err
+					}
+
+					stages := metrics.GetCycleTimeStages(pr.Creator.Username, pr.CreatedAt, endAt, timelineEvents)
+					stagesList = append(stagesList, stages)
+
+					cycleTimeReport.PullRequests = append(cycleTimeReport.PullRequests, domain.CycleTimePullRequest{
+						Number:          pr.Number,
+						Title:           pr.Title,
+						Author:          pr.Creator.Username,
+						MergedAt:        pr.MergedAt,
+						CycleTimeStages: utilities.ConvertCycleTimeStagesToString(stages),
+					})
+				}
+
+				currentPage++
+			}
+
+			cycleTimeReport.Average = utilities.ConvertCycleTimeStagesToString(metrics.AverageCycleTimeStages(stagesList))
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(cycleTimeReport)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+				return nil
+			}
+
+			fmt.Printf("Number of pull requests : %v\n", len(cycleTimeReport.PullRequests))
+			fmt.Println()
+			tablePrinter.PrintCycleTimeReport(cycleTimeReport)
+
+			return nil
+		},
+	}
+
+	return &cycleTimeCmd
+}
@@ -1,6 +1,8 @@
 package find
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -14,76 +16,190 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+const defaultPageSize = 100
+
 type userReposService interface {
-	GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error)
+	SelectRepositories(authToken string, selector domain.RepositorySelector) ([]domain.Repository, error)
 }
 
 type pullRequestsService interface {
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
+	FilterPullRequests(pullRequests []domain.PullRequest, filter domain.PullRequestFilter) []domain.PullRequest
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
 }
 
+type teamsService interface {
+	GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+	FilterPullRequests(pullRequests []domain.PullRequest, members []string, teamRole string) []domain.PullRequest
+}
+
 type tablePrinter interface {
 	PrintPullRequest(pullRequests []domain.PullRequest)
 }
 
 type utilities interface {
 	ClearTerminalScreen()
+	IsTerminal() bool
+	MatchesRepositoryPattern(repository, pattern string) bool
 }
 
-// NewCmd creates a new command to prompt several questions to user to retrieve pull requests..
-func NewCmd(cfg config.Config, userReposService userReposService, pullRequestsService pullRequestsService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
-	var authToken string
+// NewCmd creates a new command to retrieve the pull requests of multiple repositories. The user is prompted to select
+// the repositories, the base branch and the state only when no repositories are provided and the command runs in a
+// terminal.
+func NewCmd(cfg config.Config, userReposService userReposService, pullRequestsService pullRequestsService, teamsService teamsService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+	var authToken, baseBranch, prState, reviewStatus, team, teamRole string
+	var repositorySelector flag.RepositorySelector
+	var repositories, creators, reviewers, labels cli.StringSlice
+	var includeBots, printJson bool
 	// var pageSize  int
 
+	reviewStatuses := []string{domain.ReviewStatusApproved, domain.ReviewStatusChangesRequested, domain.ReviewStatusPending}
+
+	pageSize := cfg.Settings.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
 	flagBuilder := flag.New(cfg)
 
 	findCmd := cli.Command{
 		Name:    "find",
 		Aliases: []string{"f"},
 		Usage:   "Find the pull requests of multiple user repositories.",
-		//
-		// @todo Add a flag to allow providing a comma separated list of pull request creator(s)  - by survey.Input or by cli.Flag.
-		// @todo Add a flag to allow providing a comma separated list of pull request reviewer(s) - by survey.Input or by cli.Flag.
-		//
 		Flags: flagBuilder.
 			AppendAuthFlag(&authToken).
+			AppendRepositoriesFlag(&repositories, false).
+			AppendAnyBaseFlag(&baseBranch).
+			AppendStateFlag(&prState).
+			AppendCreatorsFlag(&creators).
+			AppendReviewersFlag(&reviewers).
+			AppendLabelsFlag(&labels).
+			AppendReviewStatusFlag(&reviewStatus, reviewStatuses).
+			AppendRepositorySelectorFlags(&repositorySelector).
+			AppendTeamFlag(&team).
+			AppendTeamRoleFlag(&teamRole).
+			AppendIncludeBotsFlag(&includeBots).
+			AppendPrintJsonFlag(&printJson).
 			GetFlags(),
 		Action: func(c *cli.Context) error {
-			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
-
-			utilities.ClearTerminalScreen()
-			spinLoader.Start()
-
-			userRepositories := getAllUserRepoNames(userReposService, authToken, cfg.Settings.PageSize)
+			if reviewStatus != "" && !isOneOf(reviewStatus, reviewStatuses) {
+				err := fmt.Errorf("invalid review status %q, expected one of [%v]", reviewStatus, strings.Join(reviewStatuses, "|"))
+				fmt.Println(err)
+				return err
+			}
 
-			spinLoader.Stop()
-			utilities.ClearTerminalScreen()
+			selector := repositorySelector.Get()
 
-			userReposPrompt := &survey.MultiSelect{
-				Message:  "Select the repos to retrieve the pull request of:",
-				Options:  userRepositories,
-				PageSize: 20,
+			interactive := len(repositories.Value()) == 0 && utilities.IsTerminal()
+			if len(repositories.Value()) == 0 && !interactive && selector.IsEmpty() {
+				err := errors.New("the repositories or the criteria to select them need to be provided when not running in a terminal")
+				fmt.Println(err)
+				return err
 			}
 
-			var selectedRepos []string
-			err := survey.AskOne(userReposPrompt, &selectedRepos)
+			teamMembers, err := teamsService.GetFilterMembers(authToken, team, teamRole)
 			if err != nil {
 				fmt.Println(err)
-				os.Exit(1)
+				return err
 			}
 
-			baseBranch := promptBranchInput()
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
 
-			prState := promptPrStateOptions(cfg.Settings.AllowedPullRequestStates, cfg.Settings.PullRequestState)
+			var selectedRepos []string
+			if interactive {
+				utilities.ClearTerminalScreen()
+				spinLoader.Start()
+
+				userRepositories, err := getSelectedRepoNames(userReposService, authToken, selector)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				spinLoader.Stop()
+				utilities.ClearTerminalScreen()
+
+				userReposPrompt := &survey.MultiSelect{
+					Message:  "Select the repos to retrieve the pull request of:",
+					Options:  userRepositories,
+					PageSize: 20,
+				}
+
+				err = survey.AskOne(userReposPrompt, &selectedRepos)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+
+				if !c.IsSet("base") {
+					baseBranch = promptBranchInput()
+				}
+
+				if !c.IsSet("state") {
+					prState = promptPrStateOptions(cfg.Settings.AllowedPullRequestStates, cfg.Settings.PullRequestState)
+				}
+
+				utilities.ClearTerminalScreen()
+			} else {
+				repos, err := getMatchingRepoNames(userReposService, utilities, authToken, repositories.Value(), selector)
+				if err != nil {
+					fmt.Println(err)
+					return err
+				}
+
+				selectedRepos = repos
+				if len(selectedRepos) == 0 {
+					err := errors.New("no repositories match the provided ones")
+					fmt.Println(err)
+					return err
+				}
+
+				for _, r := range selectedRepos {
+					if len(strings.Split(r, "/")) != 2 {
+						err := fmt.Errorf("invalid repository %q, expected format 'owner/repository'", r)
+						fmt.Println(err)
+						return err
+					}
+				}
+			}
 
-			utilities.ClearTerminalScreen()
+			pullRequests, err := getPullRequestsOfRepos(pullRequestsService, selectedRepos, authToken, baseBranch, prState, pageSize, spinLoader, interactive)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			pullRequests = teamsService.FilterPullRequests(pullRequests, teamMembers, teamRole)
+			pullRequests, automatedPullRequests := pullRequestsService.FilterAutomatedPullRequests(pullRequests, includeBots)
+			pullRequests = pullRequestsService.FilterPullRequests(pullRequests, domain.PullRequestFilter{
+				Creators:     creators.Value(),
+				Reviewers:    reviewers.Value(),
+				Labels:       labels.Value(),
+				ReviewStatus: reviewStatus,
+			})
+
+			if interactive {
+				utilities.ClearTerminalScreen()
+			}
 
-			pullRequests := getPullRequestsOfRepos(pullRequestsService, selectedRepos, authToken, baseBranch, prState, cfg.Settings.PageSize, spinLoader)
+			if printJson {
+				jsonBytes, err := json.Marshal(pullRequests)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
 
-			utilities.ClearTerminalScreen()
+				fmt.Printf("%s\n", string(jsonBytes))
+				return nil
+			}
 
 			tablePrinter.PrintPullRequest(pullRequests)
 
+			if !includeBots && len(automatedPullRequests) > 0 {
+				fmt.Printf("Excluded %d pull requests created by bots and automation accounts. Use --include_bots to list them.\n", len(automatedPullRequests))
+			}
+
 			return nil
 		},
 	}
@@ -91,6 +207,17 @@ func NewCmd(cfg config.Config, userReposService userReposService, pullRequestsSe
 	return &findCmd
 }
 
+// isOneOf checks whether a value is included in a list of values.
+func isOneOf(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
 // promptBranchInput asks for branch and returns the provided option.
 func promptBranchInput() string {
 	var baseBranch string
@@ -125,48 +252,86 @@ func promptPrStateOptions(availableOptions []string, defaultPrState string) stri
 	return prState
 }
 
-// getAllUserRepoNames retrieve all the repos that the user has access to and returns a list of their names.
-func getAllUserRepoNames(userReposService userReposService, authToken string, pageSize int) []string {
-	var userRepositories []string
-	currentPage := 1
+// getSelectedRepoNames retrieves the repositories that match the criteria of the selector and returns a list of their
+// names.
+func getSelectedRepoNames(userReposService userReposService, authToken string, selector domain.RepositorySelector) ([]string, error) {
+	repositories, err := userReposService.SelectRepositories(authToken, selector)
+	if err != nil {
+		return []string{}, err
+	}
 
-	for {
-		userRepos, err := userReposService.GetUserRepos(authToken, pageSize, currentPage)
-		if err != nil {
-			fmt.Println(err)
-			return []string{}
-		}
+	var repoNames []string
+	for _, r := range repositories {
+		repoNames = append(repoNames, r.FullName)
+	}
 
-		for _, r := range userRepos.Repositories {
-			userRepositories = append(userRepositories, r.FullName)
-		}
+	return repoNames, nil
+}
 
-		if len(userRepos.Repositories) < pageSize {
-			break
+// getMatchingRepoNames returns the full names of the provided repositories. The ones that contain a glob pattern are
+// replaced by the selected repositories that match the pattern. In case no repositories are provided, all the selected
+// repositories are returned.
+func getMatchingRepoNames(userReposService userReposService, utilities utilities, authToken string, repositories []string, selector domain.RepositorySelector) ([]string, error) {
+	if len(repositories) == 0 {
+		return getSelectedRepoNames(userReposService, authToken, selector)
+	}
+
+	var userRepositories []string
+	userReposLoaded := false
+
+	var repoNames []string
+	distinctRepoNames := make(map[string]bool)
+	for _, r := range repositories {
+		matchingRepos := []string{r}
+		if strings.ContainsAny(r, "*?[") {
+			if !userReposLoaded {
+				selectedRepos, err := getSelectedRepoNames(userReposService, authToken, selector)
+				if err != nil {
+					return []string{}, err
+				}
+
+				userRepositories = selectedRepos
+				userReposLoaded = true
+			}
+
+			matchingRepos = []string{}
+			for _, ur := range userRepositories {
+				if utilities.MatchesRepositoryPattern(ur, r) {
+					matchingRepos = append(matchingRepos, ur)
+				}
+			}
 		}
 
-		currentPage++
+		for _, mr := range matchingRepos {
+			if !distinctRepoNames[mr] {
+				distinctRepoNames[mr] = true
+				repoNames = append(repoNames, mr)
+			}
+		}
 	}
 
-	return userRepositories
+	return repoNames, nil
 }
 
-// getPullRequestsOfRepos retrieves all the pull requests of the provided repos.
-func getPullRequestsOfRepos(pullRequestsService pullRequestsService, userRepos []string, authToken, baseBranch, prState string, pageSize int, spinLoader *spinner.Spinner) []domain.PullRequest {
+// getPullRequestsOfRepos retrieves all the pull requests of the provided repos. The progress is displayed only when
+// running interactively.
+func getPullRequestsOfRepos(pullRequestsService pullRequestsService, userRepos []string, authToken, baseBranch, prState string, pageSize int, spinLoader *spinner.Spinner, showProgress bool) ([]domain.PullRequest, error) {
 	var pullRequests []domain.PullRequest
 	for _, r := range userRepos {
 		details := strings.Split(r, "/")
 		currentPage := 1
 
-		fmt.Printf("Retrieving pull requests of : %v/%v...\n", details[0], details[1])
+		if showProgress {
+			fmt.Printf("Retrieving pull requests of : %v/%v...\n", details[0], details[1])
 
-		spinLoader.Start()
+			spinLoader.Start()
+		}
 
 		for {
 			prs, err := pullRequestsService.GetPullRequestsOfRepository(authToken, details[0], details[1], baseBranch, prState, pageSize, currentPage)
 			if err != nil {
-				fmt.Println(err)
-				os.Exit(1)
+				spinLoader.Stop()
+				return nil, fmt.Errorf("failed to get the pull requests of %v : %v", r, err)
 			}
 
 			pullRequests = append(pullRequests, prs.PullRequests...)
@@ -178,8 +343,10 @@ func getPullRequestsOfRepos(pullRequestsService pullRequestsService, userRepos [
 			currentPage++
 		}
 
-		spinLoader.Stop()
+		if showProgress {
+			spinLoader.Stop()
+		}
 	}
 
-	return pullRequests
+	return pullRequests, nil
 }
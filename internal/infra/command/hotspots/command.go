@@ -0,0 +1,152 @@
+package hotspots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
+	"github.com/eujoy/gitpr/pkg/utils"
+	"github.com/urfave/cli/v2"
+)
+
+type repositoryService interface {
+	GetCommitsWithFiles(authToken, repoOwner, repository, branch, startTag, endTag string, since, until time.Time, skipCommit func(commit domain.Commit) bool, isExcluded func(filename string) bool) ([]domain.Commit, error)
+}
+
+type codeOwnersService interface {
+	GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error)
+	GetFileOwners(codeOwners domain.CodeOwners, filename string) []string
+}
+
+type tablePrinter interface {
+	PrintHotspotReport(hotspotReport domain.HotspotReport)
+	PrintHotspotReportCsv(hotspotReport domain.HotspotReport)
+}
+
+// NewCmd creates a new command to report the most frequently modified files and directories of a repository.
+func NewCmd(cfg config.Config, repositoryService repositoryService, codeOwnersService codeOwnersService, tablePrinter tablePrinter) *cli.Command {
+	var authToken, repoOwner, repository, baseBranch string
+	var startDateStr, endDateStr, startTag, endTag string
+	var depth, top int
+	var printJson, printCsv, excludeGenerated, byOwner bool
+
+	flagBuilder := flag.New(cfg)
+
+	hotspotsCmd := cli.Command{
+		Name:    "hotspots",
+		Aliases: []string{"hs"},
+		Usage:   "Retrieves the commits of a repository for a time period or between two tags and reports the most frequently modified files and directories along with their churn and authors.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendBaseFlag(&baseBranch).
+			AppendStartDateFlag(&startDateStr, false).
+			AppendEndDateFlag(&endDateStr, false).
+			AppendStartTagFlag(&startTag, false).
+			AppendEndTagFlag(&endTag, false).
+			AppendDepthFlag(&depth).
+			AppendTopFlag(&top).
+			AppendExcludeGeneratedFlag(&excludeGenerated).
+			AppendByOwnerFlag(&byOwner).
+			AppendPrintJsonFlag(&printJson).
+			AppendPrintCsvFlag(&printCsv).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			if startTag == "" && (startDateStr == "" || endDateStr == "") {
+				err := errors.New("either the start tag or both the start and end dates need to be provided")
+				fmt.Println(err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			var startDate, endDate time.Time
+			if startTag == "" {
+				var err error
+				startDate, err = time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 00:00:00", startDateStr))
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Printf("Failed to parse date %q with error : %v\n", startDateStr, err)
+					return err
+				}
+
+				endDate, err = time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 23:59:59", endDateStr))
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Printf("Failed to parse date %q with error : %v\n", endDateStr, err)
+					return err
+				}
+			}
+
+			var isExcluded func(filename string) bool
+			if excludeGenerated {
+				generatedPaths, err := utils.NewPathFilter(nil, cfg.PullRequestSize.ExcludedPaths)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				isExcluded = generatedPaths.Excludes
+			}
+
+			detailedCommits, err := repositoryService.GetCommitsWithFiles(authToken, repoOwner, repository, baseBranch, startTag, endTag, startDate, endDate, nil, isExcluded)
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			hotspotReport := metrics.BuildHotspotReport(detailedCommits, depth, top)
+
+			if byOwner {
+				ref := baseBranch
+				if startTag != "" {
+					ref = endTag
+				}
+
+				codeOwners, err := codeOwnersService.GetCodeOwners(authToken, repoOwner, repository, ref)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				getFileOwners := func(filename string) []string {
+					return codeOwnersService.GetFileOwners(codeOwners, filename)
+				}
+				hotspotReport.Owners = metrics.BuildOwnerHotspots(detailedCommits, getFileOwners, top)
+			}
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(hotspotReport)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else if printCsv {
+				tablePrinter.PrintHotspotReportCsv(hotspotReport)
+			} else {
+				fmt.Printf("Number of commits : %v\n", hotspotReport.Commits)
+				fmt.Println()
+				tablePrinter.PrintHotspotReport(hotspotReport)
+			}
+
+			return nil
+		},
+	}
+
+	return &hotspotsCmd
+}
@@ -0,0 +1,169 @@
+package inbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
+	"github.com/urfave/cli/v2"
+)
+
+type userReposService interface {
+	GetAuthenticatedUser(authToken string) (domain.User, error)
+}
+
+type pullRequestService interface {
+	GetPullRequestTimeline(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.TimelineEvent, error)
+	SearchPullRequests(authToken, query string) ([]domain.SearchIssue, error)
+}
+
+type automationService interface {
+	IsAutomatedUser(user domain.User) bool
+}
+
+type tablePrinter interface {
+	PrintInbox(inboxItems []domain.InboxItem)
+}
+
+type utilities interface {
+	ConvertDurationToString(dur time.Duration) string
+}
+
+// NewCmd creates a new command to list the pull requests that wait for an action of the authenticated user.
+func NewCmd(cfg config.Config, userReposService userReposService, pullRequestService pullRequestService, automationService automationService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+	var authToken, organization string
+	var printJson, includeBots bool
+
+	flagBuilder := flag.New(cfg)
+
+	inboxCmd := cli.Command{
+		Name:    "inbox",
+		Aliases: []string{"in"},
+		Usage:   "Lists the open pull requests of all the accessible repositories that wait for you, which are the ones you need to review or re-review and the ones of yours that got feedback or are ready to be merged, sorted by urgency.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOrganizationFlag(&organization).
+			AppendIncludeBotsFlag(&includeBots).
+			AppendPrintJsonFlag(&printJson).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			user, err := userReposService.GetAuthenticatedUser(authToken)
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			qualifiers := "is:open archived:false"
+			if organization != "" {
+				qualifiers = fmt.Sprintf("%v org:%v", qualifiers, organization)
+			}
+
+			queries := []string{
+				fmt.Sprintf("%v review-requested:%v", qualifiers, user.Username),
+				fmt.Sprintf("%v reviewed-by:%v -author:%v", qualifiers, user.Username, user.Username),
+				fmt.Sprintf("%v author:%v", qualifiers, user.Username),
+			}
+
+			var pullRequests []domain.SearchIssue
+			requested := make(map[string]bool)
+			distinctPullRequests := make(map[string]bool)
+			for idx, query := range queries {
+				results, err := pullRequestService.SearchPullRequests(authToken, query)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				for _, pr := range results {
+					key := fmt.Sprintf("%v#%v", pr.GetRepository(), pr.Number)
+					if idx == 0 {
+						requested[key] = true
+					}
+
+					if !distinctPullRequests[key] {
+						distinctPullRequests[key] = true
+						pullRequests = append(pullRequests, pr)
+					}
+				}
+			}
+
+			now := time.Now()
+			inboxItems := []domain.InboxItem{}
+			for _, pr := range pullRequests {
+				repository := pr.GetRepository()
+				details := strings.Split(repository, "/")
+				if len(details) != 2 {
+					continue
+				}
+
+				timelineEvents, err := pullRequestService.GetPullRequestTimeline(authToken, details[0], details[1], pr.Number)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Printf("Failed to get the timeline of pull request #%v of %v with error : %v\n", pr.Number, repository, err)
+					return err
+				}
+
+				var events []domain.TimelineEvent
+				for _, e := range timelineEvents {
+					isFeedback := e.Event == "commented" || e.Event == "reviewed"
+					if isFeedback && !includeBots && (automationService.IsAutomatedUser(e.User) || automationService.IsAutomatedUser(e.Actor)) {
+						continue
+					}
+
+					events = append(events, e)
+				}
+
+				key := fmt.Sprintf("%v#%v", repository, pr.Number)
+				reason, since := metrics.GetInboxReason(user.Username, pr.Creator.Username, requested[key], pr.CreatedAt, events)
+				if reason == "" || (reason == metrics.InboxReadyToMerge && pr.Draft) {
+					continue
+				}
+
+				inboxItems = append(inboxItems, domain.InboxItem{
+					Repository:   repository,
+					Number:       pr.Number,
+					Title:        pr.Title,
+					HtmlUrl:      pr.HtmlUrl,
+					Author:       pr.Creator.Username,
+					Reason:       reason,
+					WaitingSince: since,
+					Waiting:      now.Sub(since),
+					StrWaiting:   utilities.ConvertDurationToString(now.Sub(since)),
+				})
+			}
+
+			metrics.SortInboxItems(inboxItems)
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(inboxItems)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else {
+				fmt.Printf("Inbox of %v\n", user.Username)
+				fmt.Println()
+				tablePrinter.PrintInbox(inboxItems)
+			}
+
+			return nil
+		},
+	}
+
+	return &inboxCmd
+}
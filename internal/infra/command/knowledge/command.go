@@ -0,0 +1,116 @@
+package knowledge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
+	"github.com/eujoy/gitpr/pkg/utils"
+	"github.com/urfave/cli/v2"
+)
+
+type repositoryService interface {
+	GetCommitsWithFiles(authToken, repoOwner, repository, branch, startTag, endTag string, since, until time.Time, skipCommit func(commit domain.Commit) bool, isExcluded func(filename string) bool) ([]domain.Commit, error)
+}
+
+type automationService interface {
+	IsAutomatedUser(user domain.User) bool
+}
+
+type tablePrinter interface {
+	PrintKnowledgeReport(knowledgeReport domain.KnowledgeReport)
+}
+
+// NewCmd creates a new command to report the knowledge concentration of the directories of a repository.
+func NewCmd(cfg config.Config, repositoryService repositoryService, automationService automationService, tablePrinter tablePrinter) *cli.Command {
+	var authToken, repoOwner, repository, baseBranch, startTag, endTag string
+	var depth, months int
+	var concentrationThreshold float64
+	var printJson, excludeGenerated, includeBots bool
+
+	flagBuilder := flag.New(cfg)
+
+	knowledgeCmd := cli.Command{
+		Name:    "knowledge",
+		Aliases: []string{"kn"},
+		Usage:   "Retrieves the recent commits of a repository and reports per directory the number of contributors, the share of the top contributor and an estimation of the bus factor.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendBaseFlag(&baseBranch).
+			AppendMonthsFlag(&months).
+			AppendStartTagFlag(&startTag, false).
+			AppendEndTagFlag(&endTag, false).
+			AppendDepthFlag(&depth).
+			AppendConcentrationThresholdFlag(&concentrationThreshold).
+			AppendExcludeGeneratedFlag(&excludeGenerated).
+			AppendIncludeBotsFlag(&includeBots).
+			AppendPrintJsonFlag(&printJson).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			if startTag == "" && months <= 0 {
+				err := errors.New("the number of months needs to be greater than zero")
+				fmt.Println(err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			until := time.Now()
+			since := until.AddDate(0, -months, 0)
+
+			skipCommit := func(commit domain.Commit) bool {
+				return !includeBots && automationService.IsAutomatedUser(commit.Author)
+			}
+
+			var isExcluded func(filename string) bool
+			if excludeGenerated {
+				generatedPaths, err := utils.NewPathFilter(nil, cfg.PullRequestSize.ExcludedPaths)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				isExcluded = generatedPaths.Excludes
+			}
+
+			detailedCommits, err := repositoryService.GetCommitsWithFiles(authToken, repoOwner, repository, baseBranch, startTag, endTag, since, until, skipCommit, isExcluded)
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			knowledgeReport := metrics.BuildKnowledgeReport(detailedCommits, depth, concentrationThreshold, cfg.Knowledge.BusFactorCoverage)
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(knowledgeReport)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else {
+				fmt.Printf("Number of commits : %v\n", knowledgeReport.Commits)
+				fmt.Println()
+				tablePrinter.PrintKnowledgeReport(knowledgeReport)
+			}
+
+			return nil
+		},
+	}
+
+	return &knowledgeCmd
+}
@@ -3,12 +3,15 @@ package prmetrics
 import (
     "encoding/json"
     "fmt"
+    "strings"
     "time"
 
     "github.com/briandowns/spinner"
     "github.com/eujoy/gitpr/internal/config"
     "github.com/eujoy/gitpr/internal/domain"
     "github.com/eujoy/gitpr/internal/infra/flag"
+    "github.com/eujoy/gitpr/pkg/metrics"
+    "github.com/eujoy/gitpr/pkg/utils"
 
     "github.com/urfave/cli/v2"
 )
@@ -17,9 +20,35 @@ const (
     defaultPageSize = 20
 )
 
+// periodMetrics wraps the metrics of the pull requests that have been created during a period.
+type periodMetrics struct {
+    prMetrics       domain.PullRequestMetrics
+    prFlowRatio     map[string]*domain.PullRequestFlowRatio
+    sizeReport      domain.PullRequestSizeReport
+    categories      []domain.CategoryBreakdown
+    categoryPeriods []domain.CategoryPeriod
+    owners          []domain.OwnerBreakdown
+    repositories    []domain.RepositoryBreakdown
+}
+
+// repositoryMetrics wraps the metrics of the pull requests of a repository that have been created during a period.
+type repositoryMetrics struct {
+    prMetricsDetails      []domain.PullRequestMetricDetails
+    automatedPullRequests []domain.PullRequest
+    prFlowRatio           map[string]*domain.PullRequestFlowRatio
+    totalAggregation      domain.TotalAggregation
+}
+
+type userReposService interface {
+    GetRepositoryNames(authToken, owner string, repositories []string, selector domain.RepositorySelector) ([]string, error)
+}
+
 type pullRequestService interface {
+    FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
     GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error)
     GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+    GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error)
+    GetPullRequestTimeline(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.TimelineEvent, error)
     GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
 }
 
@@ -27,9 +56,35 @@ type repositoryService interface {
     GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
 }
 
+type teamsService interface {
+    GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+    IsTeamPullRequest(pullRequest domain.PullRequest, members []string, teamRole string) bool
+}
+
+type automationService interface {
+    GetAutomationSummary(automatedPullRequests []domain.PullRequest) domain.AutomationSummary
+}
+
+type categoriesService interface {
+    GetCategory(pullRequest domain.PullRequest) string
+    GetCategoryNames() []string
+}
+
+type codeOwnersService interface {
+    GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error)
+    GetPullRequestOwners(codeOwners domain.CodeOwners, files []domain.CommitFile) []string
+}
+
 type tablePrinter interface {
     PrintPullRequestFlowRatio(flowRatioData map[string]*domain.PullRequestFlowRatio)
     PrintPullRequestMetrics(pullRequests domain.PullRequestMetrics)
+    PrintPullRequestSizeReport(sizeReport domain.PullRequestSizeReport)
+    PrintAutomationSummary(automationSummary domain.AutomationSummary)
+    PrintPullRequestMetricsDelta(metricsDelta domain.PullRequestMetricsDelta)
+    PrintCategoryBreakdown(categoryBreakdown []domain.CategoryBreakdown)
+    PrintCategoryPeriods(categoryPeriods []domain.CategoryPeriod)
+    PrintOwnerBreakdown(ownerBreakdown []domain.OwnerBreakdown)
+    PrintRepositoryBreakdown(repositoryBreakdown []domain.RepositoryBreakdown)
 }
 
 type utilities interface {
@@ -37,35 +92,79 @@ type utilities interface {
     GetPageOptions(respLength int, pageSize int, currentPage int) []string
     GetNextPageNumberOrExit(surveySelection string, currentPage int) (int, bool)
     ConvertDurationToString(dur time.Duration) string
+    RunConcurrently(count int, fn func(idx int) error) error
+    GetValidPullRequestState(prState string) string
+    ConvertCycleTimeStagesToString(stages domain.CycleTimeStages) domain.CycleTimeStages
 }
 
-// NewCmd creates a new command to retrieve pull requests for a repo.
-func NewCmd(cfg config.Config, pullRequestService pullRequestService, repositoryService repositoryService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
-    var authToken, repoOwner, repository, baseBranch, prState string
+// NewCmd creates a new command to retrieve pull requests for one or more repos.
+func NewCmd(cfg config.Config, userReposService userReposService, pullRequestService pullRequestService, repositoryService repositoryService, teamsService teamsService, automationService automationService, categoriesService categoriesService, codeOwnersService codeOwnersService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+    var authToken, repoOwner, baseBranch, prState, team, teamRole, period, compareTo string
     var startDateStr, endDateStr string
-    var printJson bool
+    var repositories cli.StringSlice
+    var repositorySelector flag.RepositorySelector
+    var printJson, excludeGenerated, includeBots, byOwner bool
 
     flagBuilder := flag.New(cfg)
 
     pullRequestsCmd := cli.Command{
         Name:    "pr-metrics",
         Aliases: []string{"m"},
-        Usage:   "Retrieves and prints the number of pull requests for a repository that have been created during a specific time period as well as the lead time of those pull requests.",
+        Usage:   "Retrieves and prints the number of pull requests for one or more repositories that have been created during a specific time period as well as the lead time of those pull requests.",
         Flags: flagBuilder.
             AppendAuthFlag(&authToken).
-            AppendOwnerFlag(&repoOwner).
-            AppendRepositoryFlag(&repository).
+            AppendOwnerFlag(&repoOwner, false).
+            AppendRepositoryNamesFlag(&repositories).
+            AppendRepositorySelectorFlags(&repositorySelector).
             AppendBaseFlag(&baseBranch).
             AppendStateFlag(&prState).
             AppendStartDateFlag(&startDateStr, false).
             AppendEndDateFlag(&endDateStr, false).
             AppendPrintJsonFlag(&printJson).
+            AppendTeamFlag(&team).
+            AppendTeamRoleFlag(&teamRole).
+            AppendPeriodFlag(&period).
+            AppendExcludeGeneratedFlag(&excludeGenerated).
+            AppendIncludeBotsFlag(&includeBots).
+            AppendCompareToFlag(&compareTo).
+            AppendByOwnerFlag(&byOwner).
             GetFlags(),
         Action: func(c *cli.Context) error {
-            prFlowRatio := make(map[string]*domain.PullRequestFlowRatio)
+            err := metrics.ValidateSizeThresholds(cfg.PullRequestSize.Thresholds)
+            if err != nil {
+                fmt.Println(err)
+                return err
+            }
+
+            selector := repositorySelector.Get()
+
+            repoNames, err := userReposService.GetRepositoryNames(authToken, repoOwner, repositories.Value(), selector)
+            if err != nil {
+                fmt.Println(err)
+                return err
+            }
+
+            multipleRepositories := len(repoNames) > 1
+
+            teamMembers, err := teamsService.GetFilterMembers(authToken, team, teamRole)
+            if err != nil {
+                fmt.Println(err)
+                return err
+            }
+
+            codeOwners := make(map[string]domain.CodeOwners)
+            if byOwner {
+                for _, repoFullName := range repoNames {
+                    details := strings.Split(repoFullName, "/")
+                    owners, err := codeOwnersService.GetCodeOwners(authToken, details[0], details[1], baseBranch)
+                    if err != nil {
+                        fmt.Println(err)
+                        return err
+                    }
+                    codeOwners[repoFullName] = owners
+                }
+            }
 
-            var prMetricsDetails []domain.PullRequestMetricDetails
-            shallContinue := true
             spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
 
             startDate, startDateParseErr := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 00:00:00", startDateStr))
@@ -78,159 +177,353 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
                 fmt.Printf("Failed to parse date %q with error : %v\n", endDateStr, endDateParseErr)
             }
 
-            totalAggregation := domain.TotalAggregation{
-                LeadTime:    time.Duration(0),
-                TimeToMerge: time.Duration(0),
+            prState = utilities.GetValidPullRequestState(prState)
+
+            // generatedPaths excludes the generated and vendored paths of the configuration from the size of the pull requests.
+            generatedPaths, err := utils.NewPathFilter(nil, cfg.PullRequestSize.ExcludedPaths)
+            if err != nil {
+                fmt.Println(err)
+                return err
             }
 
-            prState = validatePrStateAndGetDefault(cfg, prState)
-            currentPage := 1
-            for {
-                spinLoader.Start()
+            // getRepositoryMetrics retrieves the pull requests of a repository created during the provided period and
+            // calculates their metrics.
+            getRepositoryMetrics := func(repoFullName string, startDate, endDate time.Time) (repositoryMetrics, error) {
+                details := strings.Split(repoFullName, "/")
+                repoOwner, repository := details[0], details[1]
 
-                prResp, err := pullRequestService.GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState, defaultPageSize, currentPage)
-                if err != nil {
-                    spinLoader.Stop()
-                    fmt.Println(err)
-                    return err
-                }
+                prFlowRatio := make(map[string]*domain.PullRequestFlowRatio)
 
-                if len(prResp.PullRequests) == 0 {
-                    spinLoader.Stop()
-                    fmt.Println("Retrieved empty list.")
-                    break
+                var prMetricsDetails []domain.PullRequestMetricDetails
+                var automatedPullRequests []domain.PullRequest
+                shallContinue := true
+
+                totalAggregation := domain.TotalAggregation{
+                    LeadTime:    time.Duration(0),
+                    TimeToMerge: time.Duration(0),
                 }
 
-                for _, pr := range prResp.PullRequests {
-                    createdAtStr := pr.CreatedAt.Format("2006-01-02")
-                    mergedAtStr := ""
+                currentPage := 1
+                for {
+                    prResp, err := pullRequestService.GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState, defaultPageSize, currentPage)
+                    if err != nil {
+                        return repositoryMetrics{}, err
+                    }
+
+                    if len(prResp.PullRequests) == 0 {
+                        break
+                    }
+
+                    pullRequests, automatedPrs := pullRequestService.FilterAutomatedPullRequests(prResp.PullRequests, includeBots)
+                    for _, pr := range automatedPrs {
+                        if pr.CreatedAt.Before(startDate) {
+                            shallContinue = false
+                        }
 
-                    if pr.MergeCommitSha != "" {
-                        if pr.MergedAt.After(startDate) && pr.MergedAt.Before(endDate) {
-                            mergedAtStr = pr.MergedAt.Format("2006-01-02")
-                            if _, ok := prFlowRatio[mergedAtStr]; !ok {
-                                prFlowRatio[mergedAtStr] = &domain.PullRequestFlowRatio{
+                        if pr.CreatedAt.After(startDate) && pr.CreatedAt.Before(endDate) && teamsService.IsTeamPullRequest(pr, teamMembers, teamRole) {
+                            automatedPullRequests = append(automatedPullRequests, pr)
+                        }
+                    }
+
+                    for _, pr := range pullRequests {
+                        if pr.CreatedAt.Before(startDate) {
+                            shallContinue = false
+                        }
+
+                        if !teamsService.IsTeamPullRequest(pr, teamMembers, teamRole) {
+                            continue
+                        }
+
+                        createdAtStr := pr.CreatedAt.Format("2006-01-02")
+                        mergedAtStr := ""
+
+                        if pr.MergeCommitSha != "" {
+                            if pr.MergedAt.After(startDate) && pr.MergedAt.Before(endDate) {
+                                mergedAtStr = pr.MergedAt.Format("2006-01-02")
+                                if _, ok := prFlowRatio[mergedAtStr]; !ok {
+                                    prFlowRatio[mergedAtStr] = &domain.PullRequestFlowRatio{
+                                        Created: 0,
+                                        Merged:  0,
+                                    }
+                                }
+
+                                prFlowRatio[mergedAtStr].Merged++
+                            }
+                        }
+
+                        if pr.CreatedAt.After(startDate) && pr.CreatedAt.Before(endDate) {
+                            if _, ok := prFlowRatio[createdAtStr]; !ok {
+                                prFlowRatio[createdAtStr] = &domain.PullRequestFlowRatio{
                                     Created: 0,
                                     Merged:  0,
                                 }
                             }
 
-                            prFlowRatio[mergedAtStr].Merged++
+                            prFlowRatio[createdAtStr].Created++
+
+                            actualLeadTime := time.Duration(0)
+                            if !pr.MergedAt.IsZero() {
+                                actualLeadTime = pr.MergedAt.Sub(pr.CreatedAt)
+                                totalAggregation.LeadTime += actualLeadTime
+                            }
+
+                            pullRequestDetails, err := pullRequestService.GetPullRequestsDetails(authToken, repoOwner, repository, pr.Number)
+                            if err != nil {
+                                return repositoryMetrics{}, err
+                            }
+
+                            firstCommitsList, err := pullRequestService.GetPullRequestsCommits(authToken, repoOwner, repository, pr.Number, 1, 1)
+                            if err != nil {
+                                return repositoryMetrics{}, fmt.Errorf("failed to get details of first commit of pull request #%v of %v with error : %v", pr.Number, repoFullName, err)
+                            }
+
+                            actualTimeToMerge := time.Until(firstCommitsList[0].Details.Committer.Date)
+
+                            if pullRequestDetails.MergeCommitSha != "" {
+                                lastCommit, err := repositoryService.GetCommitDetails(authToken, repoOwner, repository, pullRequestDetails.MergeCommitSha)
+                                if err != nil {
+                                    return repositoryMetrics{}, fmt.Errorf("failed to get details of last commit of pull request #%v of %v with error : %v", pr.Number, repoFullName, err)
+                                }
+
+                                actualTimeToMerge = lastCommit.Details.Committer.Date.Sub(firstCommitsList[0].Details.Committer.Date)
+                                totalAggregation.TimeToMerge += actualTimeToMerge
+                            }
+
+                            prMetric := domain.PullRequestMetricDetails{
+                                Number:         pr.Number,
+                                Title:          pr.Title,
+                                LeadTime:       actualLeadTime,
+                                TimeToMerge:    actualTimeToMerge,
+                                StrLeadTime:    utilities.ConvertDurationToString(actualLeadTime),
+                                StrTimeToMerge: utilities.ConvertDurationToString(actualTimeToMerge),
+                                CreatedAt:      pullRequestDetails.CreatedAt,
+                                Comments:       pullRequestDetails.Comments,
+                                ReviewComments: pullRequestDetails.ReviewComments,
+                                Commits:        pullRequestDetails.Commits,
+                                Additions:      pullRequestDetails.Additions,
+                                Deletions:      pullRequestDetails.Deletions,
+                                ChangedFiles:   pullRequestDetails.ChangedFiles,
+                            }
+
+                            if multipleRepositories {
+                                prMetric.Repository = repoFullName
+                            }
+
+                            var prFiles []domain.CommitFile
+                            if excludeGenerated || byOwner {
+                                prFiles, err = pullRequestService.GetPullRequestFiles(authToken, repoOwner, repository, pr.Number)
+                                if err != nil {
+                                    return repositoryMetrics{}, fmt.Errorf("failed to get the files of pull request #%v of %v with error : %v", pr.Number, repoFullName, err)
+                                }
+                            }
+
+                            prMetric.SizeLines = prMetric.Additions + prMetric.Deletions
+                            if excludeGenerated {
+                                prMetric.SizeLines = metrics.GetSizeLines(prFiles, generatedPaths.Excludes)
+                            }
+                            prMetric.Size = metrics.GetSizeLabel(prMetric.SizeLines, cfg.PullRequestSize.Thresholds)
+                            prMetric.Category = categoriesService.GetCategory(pr)
+                            if byOwner {
+                                prMetric.Owners = codeOwnersService.GetPullRequestOwners(codeOwners[repoFullName], prFiles)
+                            }
+
+                            // The cycle time stages are only part of the json output, so the timeline is not fetched otherwise.
+                            if printJson {
+                                timelineEvents, err := pullRequestService.GetPullRequestTimeline(authToken, repoOwner, repository, pr.Number)
+                                if err != nil {
+                                    return repositoryMetrics{}, fmt.Errorf("failed to get the timeline of pull request #%v of %v with error : %v", pr.Number, repoFullName, err)
+                                }
+
+                                cycleTimeEnd := time.Now()
+                                if !pr.MergedAt.IsZero() {
+                                    cycleTimeEnd = pr.MergedAt
+                                } else if !pr.ClosedAt.IsZero() {
+                                    cycleTimeEnd = pr.ClosedAt
+                                }
+                                prMetric.CycleTimeStages = utilities.ConvertCycleTimeStagesToString(metrics.GetCycleTimeStages(pr.Creator.Username, pr.CreatedAt, cycleTimeEnd, timelineEvents))
+                            }
+
+                            updateTotals(&totalAggregation, prMetric)
+
+                            prMetricsDetails = append(prMetricsDetails, prMetric)
                         }
                     }
 
-                    if pr.CreatedAt.After(startDate) && pr.CreatedAt.Before(endDate) {
-                        if _, ok := prFlowRatio[createdAtStr]; !ok {
-                            prFlowRatio[createdAtStr] = &domain.PullRequestFlowRatio{
+                    if !shallContinue {
+                        break
+                    }
+                    currentPage++
+                }
+
+                return repositoryMetrics{
+                    prMetricsDetails:      prMetricsDetails,
+                    automatedPullRequests: automatedPullRequests,
+                    prFlowRatio:           prFlowRatio,
+                    totalAggregation:      totalAggregation,
+                }, nil
+            }
+
+            // calculateMetrics retrieves the pull requests of all the repositories, created during the provided period,
+            // concurrently and calculates their metrics in aggregate.
+            calculateMetrics := func(startDate, endDate time.Time) (periodMetrics, error) {
+                spinLoader.Start()
+
+                repoMetrics := make([]repositoryMetrics, len(repoNames))
+                err := utilities.RunConcurrently(len(repoNames), func(idx int) error {
+                    rm, err := getRepositoryMetrics(repoNames[idx], startDate, endDate)
+                    if err != nil {
+                        return err
+                    }
+
+                    repoMetrics[idx] = rm
+                    return nil
+                })
+
+                spinLoader.Stop()
+
+                if err != nil {
+                    fmt.Println(err)
+                    return periodMetrics{}, err
+                }
+
+                prFlowRatio := make(map[string]*domain.PullRequestFlowRatio)
+
+                var prMetricsDetails []domain.PullRequestMetricDetails
+                var automatedPullRequests []domain.PullRequest
+                var totalAggregation domain.TotalAggregation
+                for _, rm := range repoMetrics {
+                    prMetricsDetails = append(prMetricsDetails, rm.prMetricsDetails...)
+                    automatedPullRequests = append(automatedPullRequests, rm.automatedPullRequests...)
+
+                    for date, fd := range rm.prFlowRatio {
+                        if _, ok := prFlowRatio[date]; !ok {
+                            prFlowRatio[date] = &domain.PullRequestFlowRatio{
                                 Created: 0,
                                 Merged:  0,
                             }
                         }
 
-                        prFlowRatio[createdAtStr].Created++
+                        prFlowRatio[date].Created += fd.Created
+                        prFlowRatio[date].Merged += fd.Merged
+                    }
 
-                        actualLeadTime := time.Duration(0)
-                        if !pr.MergedAt.IsZero() {
-                            actualLeadTime = pr.MergedAt.Sub(pr.CreatedAt)
-                            totalAggregation.LeadTime += actualLeadTime
-                        }
+                    mergeTotals(&totalAggregation, rm.totalAggregation)
+                }
 
-                        pullRequestDetails, err := pullRequestService.GetPullRequestsDetails(authToken, repoOwner, repository, pr.Number)
-                        if err != nil {
-                            spinLoader.Stop()
-                            fmt.Println(err)
-                            return err
-                        }
+                totalAggregation.StrLeadTime = utilities.ConvertDurationToString(totalAggregation.LeadTime)
+                totalAggregation.StrTimeToMerge = utilities.ConvertDurationToString(totalAggregation.TimeToMerge)
+                automationSummary := automationService.GetAutomationSummary(automatedPullRequests)
+                automationSummary.StrAvgLeadTime = utilities.ConvertDurationToString(automationSummary.AvgLeadTime)
 
-                        firstCommitsList, err := pullRequestService.GetPullRequestsCommits(authToken, repoOwner, repository, pr.Number, 1, 1)
-                        if err != nil {
-                            spinLoader.Stop()
-                            fmt.Printf("Failed to get details of first commit with error : %v\n", err)
-                            return err
-                        }
+                prMetrics := domain.PullRequestMetrics{
+                    PRDetails:  prMetricsDetails,
+                    Total:      totalAggregation,
+                    Average:    calculateAvgAggregation(utilities, len(prMetricsDetails), totalAggregation),
+                    Automation: automationSummary,
+                }
 
-                        actualTimeToMerge := time.Until(firstCommitsList[0].Details.Committer.Date)
+                totalCreated := 0
+                totalMerged := 0
+                for _, fd := range prFlowRatio {
+                    totalCreated += fd.Created
+                    totalMerged += fd.Merged
 
-                        if pullRequestDetails.MergeCommitSha != "" {
-                            lastCommit, err := repositoryService.GetCommitDetails(authToken, repoOwner, repository, pullRequestDetails.MergeCommitSha)
-                            if err != nil {
-                                spinLoader.Stop()
-                                fmt.Printf("Failed to get details of last commit with error : %v\n", err)
-                                return err
-                            }
+                    ratio := float64(fd.Created) / float64(fd.Merged)
+                    fd.Ratio = fmt.Sprintf("%.2f", ratio)
+                }
 
-                            actualTimeToMerge = lastCommit.Details.Committer.Date.Sub(firstCommitsList[0].Details.Committer.Date)
-                            totalAggregation.TimeToMerge += actualTimeToMerge
-                        }
+                prFlowRatio["Summary"] = &domain.PullRequestFlowRatio{
+                    Created: totalCreated,
+                    Merged:  totalMerged,
+                    Ratio:   fmt.Sprintf("%.2f", float64(totalCreated)/float64(totalMerged)),
+                }
 
-                        prMetric := domain.PullRequestMetricDetails{
-                            Number:         pr.Number,
-                            Title:          pr.Title,
-                            LeadTime:       actualLeadTime,
-                            TimeToMerge:    actualTimeToMerge,
-                            StrLeadTime:    utilities.ConvertDurationToString(actualLeadTime),
-                            StrTimeToMerge: utilities.ConvertDurationToString(actualTimeToMerge),
-                            CreatedAt:      pullRequestDetails.CreatedAt,
-                            Comments:       pullRequestDetails.Comments,
-                            ReviewComments: pullRequestDetails.ReviewComments,
-                            Commits:        pullRequestDetails.Commits,
-                            Additions:      pullRequestDetails.Additions,
-                            Deletions:      pullRequestDetails.Deletions,
-                            ChangedFiles:   pullRequestDetails.ChangedFiles,
-                        }
-                        updateTotals(&totalAggregation, prMetric)
+                sizeReport := metrics.BuildSizeReport(prMetricsDetails, period, cfg.PullRequestSize.TopOversized)
+                for idx := range sizeReport.Buckets {
+                    sizeReport.Buckets[idx].StrAvgLeadTime = utilities.ConvertDurationToString(sizeReport.Buckets[idx].AvgLeadTime)
+                }
 
-                        prMetricsDetails = append(prMetricsDetails, prMetric)
+                var ownerBreakdown []domain.OwnerBreakdown
+                if byOwner {
+                    ownerBreakdown = metrics.BuildOwnerBreakdown(prMetricsDetails)
+                    for idx := range ownerBreakdown {
+                        ownerBreakdown[idx].StrAvgLeadTime = utilities.ConvertDurationToString(ownerBreakdown[idx].AvgLeadTime)
                     }
+                }
 
-                    if pr.CreatedAt.Before(startDate) {
-                        shallContinue = false
+                var repositoryBreakdown []domain.RepositoryBreakdown
+                if multipleRepositories {
+                    repositoryBreakdown = metrics.BuildRepositoryBreakdown(prMetricsDetails)
+                    for idx := range repositoryBreakdown {
+                        repositoryBreakdown[idx].StrAvgLeadTime = utilities.ConvertDurationToString(repositoryBreakdown[idx].AvgLeadTime)
+                        repositoryBreakdown[idx].StrAvgTimeToMerge = utilities.ConvertDurationToString(repositoryBreakdown[idx].AvgTimeToMerge)
                     }
                 }
 
-                if !shallContinue {
-                    break
-                }
-                currentPage++
+                return periodMetrics{
+                    prMetrics:       prMetrics,
+                    prFlowRatio:     prFlowRatio,
+                    sizeReport:      sizeReport,
+                    categories:      metrics.BuildCategoryBreakdown(prMetricsDetails, categoriesService.GetCategoryNames()),
+                    categoryPeriods: metrics.BuildCategoryPeriods(prMetricsDetails, categoriesService.GetCategoryNames(), period),
+                    owners:          ownerBreakdown,
+                    repositories:    repositoryBreakdown,
+                }, nil
             }
 
-            totalAggregation.StrLeadTime = utilities.ConvertDurationToString(totalAggregation.LeadTime)
-            totalAggregation.StrTimeToMerge = utilities.ConvertDurationToString(totalAggregation.TimeToMerge)
-            prMetrics := domain.PullRequestMetrics{
-                PRDetails: prMetricsDetails,
-                Total:     totalAggregation,
-                Average:   calculateAvgAggregation(utilities, len(prMetricsDetails), totalAggregation),
+            current, err := calculateMetrics(startDate, endDate)
+            if err != nil {
+                return err
             }
 
-            totalCreated := 0
-            totalMerged := 0
-            for _, fd := range prFlowRatio {
-                totalCreated += fd.Created
-                totalMerged += fd.Merged
+            var metricsDelta *domain.PullRequestMetricsDelta
+            if compareTo != "" {
+                if startDateParseErr != nil || endDateParseErr != nil {
+                    err := fmt.Errorf("a valid start and end date is required to compare the metrics with another period")
+                    fmt.Println(err)
+                    return err
+                }
 
-                ratio := float64(fd.Created) / float64(fd.Merged)
-                fd.Ratio = fmt.Sprintf("%.2f", ratio)
-            }
+                baselineStartDate, baselineEndDate, err := metrics.GetComparisonPeriod(compareTo, startDate, endDate)
+                if err != nil {
+                    fmt.Println(err)
+                    return err
+                }
 
-            prFlowRatio["Summary"] = &domain.PullRequestFlowRatio{
-                Created: totalCreated,
-                Merged:  totalMerged,
-                Ratio:   fmt.Sprintf("%.2f", float64(totalCreated)/float64(totalMerged)),
-            }
+                baseline, err := calculateMetrics(baselineStartDate, baselineEndDate)
+                if err != nil {
+                    return err
+                }
 
-            spinLoader.Stop()
+                delta := metrics.BuildMetricsDelta(current.prMetrics, baseline.prMetrics, current.prFlowRatio["Summary"], baseline.prFlowRatio["Summary"])
+                delta.BaselineStartDate = baselineStartDate.Format("2006-01-02")
+                delta.BaselineEndDate = baselineEndDate.Format("2006-01-02")
+                metricsDelta = &delta
+            }
 
             if printJson {
                 type jsonOutput struct {
                     NumOfPullRequests int                                     `json:"num_of_pull_requests"`
                     PrMetrics         domain.PullRequestMetrics               `json:"data"`
                     PrFlowRatio       map[string]*domain.PullRequestFlowRatio `json:"flow_ratio"`
+                    Size              domain.PullRequestSizeReport            `json:"size"`
+                    Categories        []domain.CategoryBreakdown              `json:"categories"`
+                    CategoryPeriods   []domain.CategoryPeriod                 `json:"category_periods"`
+                    Owners            []domain.OwnerBreakdown                 `json:"owners,omitempty"`
+                    Repositories      []domain.RepositoryBreakdown            `json:"repositories,omitempty"`
+                    Delta             *domain.PullRequestMetricsDelta         `json:"delta,omitempty"`
                 }
 
                 jOut := jsonOutput{
-                    NumOfPullRequests: len(prMetricsDetails),
-                    PrMetrics:         prMetrics,
-                    PrFlowRatio:       prFlowRatio,
+                    NumOfPullRequests: len(current.prMetrics.PRDetails),
+                    PrMetrics:         current.prMetrics,
+                    PrFlowRatio:       current.prFlowRatio,
+                    Size:              current.sizeReport,
+                    Categories:        current.categories,
+                    CategoryPeriods:   current.categoryPeriods,
+                    Owners:            current.owners,
+                    Repositories:      current.repositories,
+                    Delta:             metricsDelta,
                 }
 
                 jsonBytes, err := json.Marshal(jOut)
@@ -241,11 +534,37 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 
                 fmt.Printf("%s\n", string(jsonBytes))
             } else {
-                fmt.Printf("Number of pull requests : %v\n", len(prMetricsDetails))
+                fmt.Printf("Number of pull requests : %v\n", len(current.prMetrics.PRDetails))
+                fmt.Println()
+                tablePrinter.PrintPullRequestMetrics(current.prMetrics)
+                fmt.Println()
+                tablePrinter.PrintPullRequestFlowRatio(current.prFlowRatio)
+                fmt.Println()
+                tablePrinter.PrintPullRequestSizeReport(current.sizeReport)
                 fmt.Println()
-                tablePrinter.PrintPullRequestMetrics(prMetrics)
+                tablePrinter.PrintCategoryBreakdown(current.categories)
                 fmt.Println()
-                tablePrinter.PrintPullRequestFlowRatio(prFlowRatio)
+                tablePrinter.PrintCategoryPeriods(current.categoryPeriods)
+
+                if byOwner {
+                    fmt.Println()
+                    tablePrinter.PrintOwnerBreakdown(current.owners)
+                }
+
+                if multipleRepositories {
+                    fmt.Println()
+                    tablePrinter.PrintRepositoryBreakdown(current.repositories)
+                }
+
+                if current.prMetrics.Automation.PullRequests > 0 {
+                    fmt.Println()
+                    tablePrinter.PrintAutomationSummary(current.prMetrics.Automation)
+                }
+
+                if metricsDelta != nil {
+                    fmt.Println()
+                    tablePrinter.PrintPullRequestMetricsDelta(*metricsDelta)
+                }
             }
 
             return nil
@@ -255,18 +574,6 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
     return &pullRequestsCmd
 }
 
-// validatePrStateAndGetDefault checks if the requested state of pull requests is valid and returns
-// it in case it is, otherwise it returns the default pull request state.
-func validatePrStateAndGetDefault(cfg config.Config, prState string) string {
-    for _, prs := range cfg.Settings.AllowedPullRequestStates {
-        if prState == prs {
-            return prState
-        }
-    }
-
-    return cfg.Settings.PullRequestState
-}
-
 func updateTotals(totalData *domain.TotalAggregation, metricDetails domain.PullRequestMetricDetails) {
     totalData.Comments += metricDetails.Comments
     totalData.ReviewComments += metricDetails.ReviewComments
@@ -276,7 +583,26 @@ func updateTotals(totalData *domain.TotalAggregation, metricDetails domain.PullR
     totalData.ChangedFiles += metricDetails.ChangedFiles
 }
 
+// mergeTotals adds the totals of a repository to the total aggregation of all the repositories.
+func mergeTotals(totalData *domain.TotalAggregation, repositoryTotalData domain.TotalAggregation) {
+    totalData.Comments += repositoryTotalData.Comments
+    totalData.ReviewComments += repositoryTotalData.ReviewComments
+    totalData.Commits += repositoryTotalData.Commits
+    totalData.Additions += repositoryTotalData.Additions
+    totalData.Deletions += repositoryTotalData.Deletions
+    totalData.ChangedFiles += repositoryTotalData.ChangedFiles
+    totalData.LeadTime += repositoryTotalData.LeadTime
+    totalData.TimeToMerge += repositoryTotalData.TimeToMerge
+}
+
 func calculateAvgAggregation(utilities utilities, prCount int, totalData domain.TotalAggregation) domain.AverageAggregation {
+    if prCount == 0 {
+        return domain.AverageAggregation{
+            StrLeadTime:    utilities.ConvertDurationToString(0),
+            StrTimeToMerge: utilities.ConvertDurationToString(0),
+        }
+    }
+
     avgLeadTime := time.Duration(totalData.LeadTime.Seconds()/float64(prCount)) * time.Second
     avgTimeToMerge := time.Duration(totalData.TimeToMerge.Seconds()/float64(prCount)) * time.Second
 
@@ -11,7 +11,9 @@ import (
 	"github.com/eujoy/gitpr/internal/config"
 	"github.com/eujoy/gitpr/internal/domain"
 	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
 	"github.com/eujoy/gitpr/pkg/publish"
+	"github.com/eujoy/gitpr/pkg/utils"
 	"github.com/urfave/cli/v2"
 )
 
@@ -19,14 +21,17 @@ const (
 	defaultPageSize                     = 20
 	pullRequestSheetNameDefaultTemplate = "OverallData-{repositoryName}"
 	releaseSheetNameDefaultTemplate     = "Release-{repositoryName}"
+	categorySheetNameDefaultTemplate    = "Categories-{repositoryName}"
 
 	defaultVersionPattern             = "^(v[\\d]+.[\\d]+.[\\d]+)$"
 	versionPatternWithServiceInitials = "^(v[\\d]+.[\\d]+.[\\d]+-(\\w){numOfInitialLetters,numOfInitialLetters})$"
 )
 
 type pullRequestService interface {
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
 	GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error)
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
 }
 
@@ -35,19 +40,34 @@ type repositoryService interface {
 	GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
 }
 
+type teamsService interface {
+	GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+	IsTeamPullRequest(pullRequest domain.PullRequest, members []string, teamRole string) bool
+}
+
+type automationService interface {
+	GetAutomationSummary(automatedPullRequests []domain.PullRequest) domain.AutomationSummary
+}
+
+type categoriesService interface {
+	GetCategory(pullRequest domain.PullRequest) string
+	GetCategoryNames() []string
+}
+
 type utilities interface {
 	ClearTerminalScreen()
 	GetPageOptions(respLength int, pageSize int, currentPage int) []string
 	GetNextPageNumberOrExit(surveySelection string, currentPage int) (int, bool)
 	ConvertDurationToString(dur time.Duration) string
+	GetValidPullRequestState(prState string) string
 }
 
 // NewCmd creates a new command to retrieve pull requests for a repo.
-func NewCmd(cfg config.Config, pullRequestService pullRequestService, repositoryService repositoryService, utilities utilities) *cli.Command {
-	var authToken, repoOwner, repository, baseBranch, prState string
-	var spreadsheetID, prSheetName, sprintSummary, relSheetName string
+func NewCmd(cfg config.Config, pullRequestService pullRequestService, repositoryService repositoryService, teamsService teamsService, automationService automationService, categoriesService categoriesService, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, baseBranch, prState, team, teamRole string
+	var spreadsheetID, prSheetName, sprintSummary, relSheetName, catSheetName string
 
-	var enableDefaultVersionPattern bool
+	var enableDefaultVersionPattern, excludeGenerated, includeBots bool
 	var enableDefaultVersionPatternWithServiceInitials bool
 	var numOfInitialLetters int
 	var useVersionPatternWithServiceInitials string
@@ -60,26 +80,43 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 		Usage:   "Retrieves the metric details for a list of sprints, prepares the report information for each one of them and publishes the report data the provided google spreadsheet.",
 		Flags: flagBuilder.
 			AppendAuthFlag(&authToken).
-			AppendOwnerFlag(&repoOwner).
+			AppendOwnerFlag(&repoOwner, true).
 			AppendRepositoryFlag(&repository).
 			AppendBaseFlag(&baseBranch).
 			AppendStateFlag(&prState).
 			AppendSpreadsheetID(&spreadsheetID).
 			AppendPullRequestSheetName(&prSheetName).
 			AppendReleaseSheetName(&relSheetName).
+			AppendCategorySheetName(&catSheetName).
 			AppendSprintSummary(&sprintSummary).
 			AppendDefaultVersionPatternFlag(&enableDefaultVersionPattern, defaultVersionPattern).
 			AppendVersionPatternWithServiceInitialsFlag(&numOfInitialLetters, versionPatternWithServiceInitials).
+			AppendTeamFlag(&team).
+			AppendTeamRoleFlag(&teamRole).
+			AppendExcludeGeneratedFlag(&excludeGenerated).
+			AppendIncludeBotsFlag(&includeBots).
 			GetFlags(),
 		Action: func(c *cli.Context) error {
 			fmt.Println("Starting the process...")
 
+			teamMembers, err := teamsService.GetFilterMembers(authToken, team, teamRole)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
 			googleSheetsService, err := publish.NewGoogleSheetsService()
 			if err != nil {
 				fmt.Printf("Failed to prepare google sheets service with error: %v\n", err)
 				return err
 			}
 
+			generatedPaths, err := utils.NewPathFilter(nil, cfg.PullRequestSize.ExcludedPaths)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
 			if numOfInitialLetters > 0 {
 				enableDefaultVersionPatternWithServiceInitials = true
 				useVersionPatternWithServiceInitials = strings.Replace(versionPatternWithServiceInitials, "numOfInitialLetters", strconv.Itoa(numOfInitialLetters), -1)
@@ -95,6 +132,10 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 				relSheetName = strings.Replace(releaseSheetNameDefaultTemplate, "{repositoryName}", repository, -1)
 			}
 
+			if catSheetName == "" {
+				catSheetName = strings.Replace(categorySheetNameDefaultTemplate, "{repositoryName}", repository, -1)
+			}
+
 			err = googleSheetsService.CreateAndCleanupOverallSheet(spreadsheetID, prSheetName)
 			if err != nil {
 				fmt.Println(err)
@@ -107,6 +148,12 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 				return err
 			}
 
+			err = googleSheetsService.CreateAndCleanupCategoryOverallSheet(spreadsheetID, catSheetName)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
 			var sprintSummaryList []domain.SprintSummary
 			err = json.Unmarshal([]byte(sprintSummary), &sprintSummaryList)
 			if err != nil {
@@ -138,7 +185,8 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 			}
 
 			pullRequestListPerDay := make(map[string][]domain.PullRequest)
-			prState = validatePrStateAndGetDefault(cfg, prState)
+			automatedPullRequestListPerDay := make(map[string][]domain.PullRequest)
+			prState = utilities.GetValidPullRequestState(prState)
 			currentPage := 1
 			for {
 				fmt.Printf("Fetch pull requests for page : %v\n", currentPage)
@@ -155,14 +203,29 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 				}
 
 				fmt.Printf("Moving pull requests from response to the map - number of pull requests : %v\n", len(prResp.PullRequests))
-				for _, pr := range prResp.PullRequests {
-					createdAtStr := pr.CreatedAt.Format("2006-01-02")
+				pullRequests, automatedPullRequests := pullRequestService.FilterAutomatedPullRequests(prResp.PullRequests, includeBots)
+				for _, pr := range automatedPullRequests {
+					if pr.CreatedAt.Before(startAt) {
+						shallContinue = false
+					}
 
-					pullRequestListPerDay[createdAtStr] = append(pullRequestListPerDay[createdAtStr], pr)
+					if teamsService.IsTeamPullRequest(pr, teamMembers, teamRole) {
+						createdAtStr := pr.CreatedAt.Format("2006-01-02")
+						automatedPullRequestListPerDay[createdAtStr] = append(automatedPullRequestListPerDay[createdAtStr], pr)
+					}
+				}
 
+				for _, pr := range pullRequests {
 					if pr.CreatedAt.Before(startAt) {
 						shallContinue = false
 					}
+
+					if !teamsService.IsTeamPullRequest(pr, teamMembers, teamRole) {
+						continue
+					}
+
+					createdAtStr := pr.CreatedAt.Format("2006-01-02")
+					pullRequestListPerDay[createdAtStr] = append(pullRequestListPerDay[createdAtStr], pr)
 				}
 
 				if !shallContinue {
@@ -176,12 +239,13 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 
 				fmt.Printf("Prepare report data for sprint with number : %v\n", sprint.Number)
 
-				var prsInSprint []domain.PullRequest
+				var prsInSprint, automatedPrsInSprint []domain.PullRequest
 				currentDate := sprint.StartDate.Time
 				for {
 					fmt.Printf("Combine all pull requests for sprint - current date is : %v\n", currentDate.Format("2006-01-02"))
 
 					prsInSprint = append(prsInSprint, pullRequestListPerDay[currentDate.Format("2006-01-02")]...)
+					automatedPrsInSprint = append(automatedPrsInSprint, automatedPullRequestListPerDay[currentDate.Format("2006-01-02")]...)
 					currentDate = currentDate.Add(24 * time.Hour)
 					if currentDate.After(sprint.EndDate.Time) {
 						break
@@ -261,6 +325,17 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 							totalAggregation.TimeToMerge += actualTimeToMerge
 						}
 
+						sizeLines := pullRequestDetails.Additions + pullRequestDetails.Deletions
+						if excludeGenerated {
+							prFiles, err := pullRequestService.GetPullRequestFiles(authToken, repoOwner, repository, pr.Number)
+							if err != nil {
+								fmt.Printf("Failed to get the files of pull request #%v with error : %v\n", pr.Number, err)
+								return err
+							}
+
+							sizeLines = metrics.GetSizeLines(prFiles, generatedPaths.Excludes)
+						}
+
 						prMetric := domain.PullRequestMetricDetails{
 							Number:         pr.Number,
 							Title:          pr.Title,
@@ -275,6 +350,8 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 							Additions:      pullRequestDetails.Additions,
 							Deletions:      pullRequestDetails.Deletions,
 							ChangedFiles:   pullRequestDetails.ChangedFiles,
+							SizeLines:      sizeLines,
+							Category:       categoriesService.GetCategory(pr),
 						}
 						updateTotals(&totalAggregation, prMetric)
 
@@ -284,10 +361,14 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 
 				totalAggregation.StrLeadTime = utilities.ConvertDurationToString(totalAggregation.LeadTime)
 				totalAggregation.StrTimeToMerge = utilities.ConvertDurationToString(totalAggregation.TimeToMerge)
+				automationSummary := automationService.GetAutomationSummary(automatedPrsInSprint)
+				automationSummary.StrAvgLeadTime = utilities.ConvertDurationToString(automationSummary.AvgLeadTime)
+
 				prMetrics := &domain.PullRequestMetrics{
-					PRDetails: prMetricsDetails,
-					Total:     totalAggregation,
-					Average:   calculateAvgAggregation(utilities, len(prMetricsDetails), totalAggregation),
+					PRDetails:  prMetricsDetails,
+					Total:      totalAggregation,
+					Average:    calculateAvgAggregation(utilities, len(prMetricsDetails), totalAggregation),
+					Automation: automationSummary,
 				}
 
 				totalCreated := 0
@@ -317,6 +398,13 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 					fmt.Printf("Failed to write report for pull requests for sprint with error : %v\n", err)
 					return err
 				}
+
+				categoryBreakdown := metrics.BuildCategoryBreakdown(prMetricsDetails, categoriesService.GetCategoryNames())
+				err = googleSheetsService.WriteCategoryReportData(spreadsheetID, catSheetName, "A1", &sprint, categoryBreakdown)
+				if err != nil {
+					fmt.Printf("Failed to write category report data to spreadsheet with error : %v\n", err)
+					return err
+				}
 			}
 
 			fmt.Println("Fetch information about the releases.")
@@ -476,18 +564,6 @@ func NewCmd(cfg config.Config, pullRequestService pullRequestService, repository
 	return &pullRequestsCmd
 }
 
-// validatePrStateAndGetDefault checks if the requested state of pull requests is valid and returns
-// it in case it is, otherwise it returns the default pull request state.
-func validatePrStateAndGetDefault(cfg config.Config, prState string) string {
-	for _, prs := range cfg.Settings.AllowedPullRequestStates {
-		if prState == prs {
-			return prState
-		}
-	}
-
-	return cfg.Settings.PullRequestState
-}
-
 func updateTotals(totalData *domain.TotalAggregation, metricDetails domain.PullRequestMetricDetails) {
 	totalData.Comments       += metricDetails.Comments
 	totalData.ReviewComments += metricDetails.ReviewComments
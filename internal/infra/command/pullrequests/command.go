@@ -13,9 +13,15 @@ import (
 )
 
 type service interface {
+    FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
     GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
 }
 
+type teamsService interface {
+    GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+    FilterPullRequests(pullRequests []domain.PullRequest, members []string, teamRole string) []domain.PullRequest
+}
+
 type tablePrinter interface {
     PrintPullRequest(pullRequests []domain.PullRequest)
 }
@@ -24,12 +30,14 @@ type utilities interface {
     ClearTerminalScreen()
     GetPageOptions(respLength int, pageSize int, currentPage int) []string
     GetNextPageNumberOrExit(surveySelection string, currentPage int) (int, bool)
+    GetValidPullRequestState(prState string) string
 }
 
 // NewCmd creates a new command to retrieve pull requests for a repo.
-func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter, utilities utilities) *cli.Command {
-    var authToken, repoOwner, repository, baseBranch, prState string
+func NewCmd(cfg config.Config, service service, teamsService teamsService, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+    var authToken, repoOwner, repository, baseBranch, prState, team, teamRole string
     var pageSize int
+    var includeBots bool
 
     flagBuilder := flag.New(cfg)
 
@@ -39,19 +47,28 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter, utili
         Usage:   "Retrieves and prints all the pull requests of a user for a repository.",
         Flags: flagBuilder.
             AppendAuthFlag(&authToken).
-            AppendOwnerFlag(&repoOwner).
+            AppendOwnerFlag(&repoOwner, true).
             AppendRepositoryFlag(&repository).
             AppendBaseFlag(&baseBranch).
             AppendStateFlag(&prState).
             AppendPageSizeFlag(&pageSize, cfg.Settings.PageSize).
+            AppendTeamFlag(&team).
+            AppendTeamRoleFlag(&teamRole).
+            AppendIncludeBotsFlag(&includeBots).
             GetFlags(),
         Action: func(c *cli.Context) error {
             var shallContinue bool
+            teamMembers, err := teamsService.GetFilterMembers(authToken, team, teamRole)
+            if err != nil {
+                fmt.Println(err)
+                return err
+            }
+
             spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
 
             currentPage := 1
 
-            prState = validatePrStateAndGetDefault(cfg, prState)
+            prState = utilities.GetValidPullRequestState(prState)
 
             for {
                 utilities.ClearTerminalScreen()
@@ -66,7 +83,8 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter, utili
                 spinLoader.Stop()
                 utilities.ClearTerminalScreen()
 
-                tablePrinter.PrintPullRequest(prResp.PullRequests)
+                pullRequests, _ := service.FilterAutomatedPullRequests(prResp.PullRequests, includeBots)
+                tablePrinter.PrintPullRequest(teamsService.FilterPullRequests(pullRequests, teamMembers, teamRole))
 
                 var whatToDo string
                 prompt := &survey.Select{
@@ -93,15 +111,3 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter, utili
 
     return &pullRequestsCmd
 }
-
-// validatePrStateAndGetDefault checks if the requested state of pull requests is valid and returns
-// it in case it is, otherwise it returns the default pull request state.
-func validatePrStateAndGetDefault(cfg config.Config, prState string) string {
-    for _, prs := range cfg.Settings.AllowedPullRequestStates {
-        if prState == prs {
-            return prState
-        }
-    }
-
-    return cfg.Settings.PullRequestState
-}
@@ -0,0 +1,523 @@
+package release
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/changelog"
+	"github.com/urfave/cli/v2"
+)
+
+const defaultPageSize = 30
+
+type service interface {
+	DeleteRelease(authToken, repoOwner, repository string, releaseID int) error
+	GetReleaseByTag(authToken, repoOwner, repository, tag string) (domain.Release, error)
+	GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
+	UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error)
+	UploadReleaseAsset(authToken, repoOwner, repository string, release domain.Release, name string, content io.Reader, size int64, replace bool) (domain.ReleaseAsset, bool, error)
+}
+
+type utilities interface {
+	BuildChecksums(files []string) (string, error)
+	Confirm(force bool, message string) (bool, error)
+	GetAssetFiles(patterns []string) ([]string, error)
+}
+
+type tablePrinter interface {
+	PrintReleaseChecklist(checklist domain.ReleaseChecklist)
+	PrintReleases(releases []domain.Release)
+}
+
+// progressReader reports the progress of reading the content of an asset while it gets uploaded.
+type progressReader struct {
+	reader     io.Reader
+	read       int64
+	onProgress func(read int64)
+}
+
+// Read reads the content and reports the total number of bytes read so far.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	r.onProgress(r.read)
+
+	return n, err
+}
+
+// NewCmd creates a new command to manage the releases of a repository.
+func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+	releaseCmd := cli.Command{
+		Name:    "release",
+		Aliases: []string{"rel"},
+		Usage:   "Provides the actions to manage the releases of a repository.",
+		Subcommands: []*cli.Command{
+			newListCmd(cfg, service, tablePrinter),
+			newStatusCmd(cfg, service, tablePrinter),
+			newEditCmd(cfg, service, utilities),
+			newPublishCmd(cfg, service, utilities),
+			newDeleteCmd(cfg, service, utilities),
+			newUploadCmd(cfg, service, utilities),
+		},
+	}
+
+	return &releaseCmd
+}
+
+// newListCmd creates the command to list the latest releases of a repository.
+func newListCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.Command {
+	var authToken, repoOwner, repository string
+	var pageSize int
+	var printJson bool
+
+	flagBuilder := flag.New(cfg)
+
+	listCmd := cli.Command{
+		Name:    "list",
+		Aliases: []string{"ls"},
+		Usage:   "Lists the latest releases of a repository, including the draft ones.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendPageSizeFlag(&pageSize, defaultPageSize).
+			AppendPrintJsonFlag(&printJson).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			releaseList, err := service.GetReleaseList(authToken, repoOwner, repository, pageSize, 1)
+			spinLoader.Stop()
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			if printJson {
+				jsonBytes, err := json.Marshal(releaseList)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+				return nil
+			}
+
+			tablePrinter.PrintReleases(releaseList)
+
+			return nil
+		},
+	}
+
+	return &listCmd
+}
+
+// newStatusCmd creates the command to report the items of the checklist of a release that are still unchecked.
+func newStatusCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.Command {
+	var authToken, repoOwner, repository, tag string
+	var printJson, failOnUnchecked bool
+
+	flagBuilder := flag.New(cfg)
+
+	statusCmd := cli.Command{
+		Name:  "status",
+		Usage: "Reports the checked and unchecked items of the checklist of a release, along with the authors of the commits that are still unverified.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendTagFlag(&tag).
+			AppendPrintJsonFlag(&printJson).
+			AppendFailOnUncheckedFlag(&failOnUnchecked).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			release, err := service.GetReleaseByTag(authToken, repoOwner, repository, tag)
+			spinLoader.Stop()
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			checklist := changelog.ParseChecklist(release.Body)
+
+			if printJson {
+				jsonBytes, err := json.Marshal(checklist)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else {
+				fmt.Printf("Release: '%v' %v\n", release.Name, release.HtmlUrl)
+				fmt.Println()
+				tablePrinter.PrintReleaseChecklist(checklist)
+			}
+
+			if failOnUnchecked && checklist.Unchecked > 0 {
+				return cli.Exit(fmt.Sprintf("Found %v unchecked items.", checklist.Unchecked), cfg.ReleaseChecklist.AlertExitCode)
+			}
+
+			return nil
+		},
+	}
+
+	return &statusCmd
+}
+
+// newEditCmd creates the command to edit the name, the description and the pre-release state of a release.
+func newEditCmd(cfg config.Config, service service, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, tag, name, body, bodyFile string
+	var preRelease, force bool
+
+	flagBuilder := flag.New(cfg)
+
+	editCmd := cli.Command{
+		Name:  "edit",
+		Usage: "Edits the name, the description or the pre-release state of a release.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendTagFlag(&tag).
+			AppendNameFlag(&name).
+			AppendBodyFlag(&body).
+			AppendBodyFileFlag(&bodyFile).
+			AppendPreReleaseFlag(&preRelease).
+			AppendForceFlag(&force).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			var releaseUpdate domain.ReleaseUpdate
+			if c.IsSet("name") {
+				releaseUpdate.Name = &name
+			}
+
+			if c.IsSet("body") {
+				releaseUpdate.Body = &body
+			}
+
+			if bodyFile != "" {
+				bodyBytes, err := ioutil.ReadFile(bodyFile)
+				if err != nil {
+					fmt.Printf("Failed to read %q with error : %v\n", bodyFile, err)
+					return err
+				}
+
+				body = string(bodyBytes)
+				releaseUpdate.Body = &body
+			}
+
+			if c.IsSet("prerelease") {
+				releaseUpdate.PreRelease = &preRelease
+			}
+
+			if releaseUpdate.Name == nil && releaseUpdate.Body == nil && releaseUpdate.PreRelease == nil {
+				err := errors.New("nothing to edit, please provide the name, the body or the pre-release state of the release")
+				fmt.Println(err)
+				return err
+			}
+
+			release, err := service.GetReleaseByTag(authToken, repoOwner, repository, tag)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			if releaseUpdate.Name != nil {
+				fmt.Printf("Name        : %v -> %v\n", release.Name, name)
+			}
+
+			if releaseUpdate.Body != nil {
+				fmt.Printf("Description :\n%v\n", body)
+			}
+
+			if releaseUpdate.PreRelease != nil {
+				fmt.Printf("Pre-release : %v -> %v\n", release.PreRelease, preRelease)
+			}
+
+			return applyReleaseUpdate(authToken, repoOwner, repository, release, releaseUpdate, force, fmt.Sprintf("Are you sure you want to edit the release of %v?", tag), service, utilities)
+		},
+	}
+
+	return &editCmd
+}
+
+// newPublishCmd creates the command to publish a draft release. The release can be required to have all the items of its
+// checklist checked before it gets published.
+func newPublishCmd(cfg config.Config, service service, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, tag string
+	var requireChecked, force bool
+
+	flagBuilder := flag.New(cfg)
+
+	publishCmd := cli.Command{
+		Name:  "publish",
+		Usage: "Publishes a draft release.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendTagFlag(&tag).
+			AppendRequireCheckedFlag(&requireChecked).
+			AppendForceFlag(&force).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			release, err := service.GetReleaseByTag(authToken, repoOwner, repository, tag)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			if !release.Draft {
+				err = fmt.Errorf("release of %v is already published", tag)
+				fmt.Println(err)
+				return err
+			}
+
+			if requireChecked {
+				checklist := changelog.ParseChecklist(release.Body)
+				if checklist.Unchecked > 0 {
+					err = fmt.Errorf("release of %v has %v unchecked items", tag, checklist.Unchecked)
+					if len(checklist.UncheckedAuthors) > 0 {
+						err = fmt.Errorf("%v, waiting for : %v", err, strings.Join(checklist.UncheckedAuthors, ", "))
+					}
+
+					fmt.Println(err)
+					return err
+				}
+			}
+
+			draft := false
+			releaseUpdate := domain.ReleaseUpdate{Draft: &draft}
+
+			return applyReleaseUpdate(authToken, repoOwner, repository, release, releaseUpdate, force, fmt.Sprintf("Are you sure you want to publish the release of %v?", tag), service, utilities)
+		},
+	}
+
+	return &publishCmd
+}
+
+// newDeleteCmd creates the command to delete a release.
+func newDeleteCmd(cfg config.Config, service service, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, tag string
+	var force bool
+
+	flagBuilder := flag.New(cfg)
+
+	deleteCmd := cli.Command{
+		Name:    "delete",
+		Aliases: []string{"rm"},
+		Usage:   "Deletes a release, leaving its tag as it is.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendTagFlag(&tag).
+			AppendForceFlag(&force).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			release, err := service.GetReleaseByTag(authToken, repoOwner, repository, tag)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			confirmed, err := utilities.Confirm(force, fmt.Sprintf("Are you sure you want to delete the release '%v' of %v?", release.Name, tag))
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			if !confirmed {
+				return nil
+			}
+
+			err = service.DeleteRelease(authToken, repoOwner, repository, release.ID)
+			if err != nil {
+				fmt.Printf("Failed to delete release with error : %v\n", err)
+				return err
+			}
+
+			fmt.Printf("Deleted release of %v\n", tag)
+
+			return nil
+		},
+	}
+
+	return &deleteCmd
+}
+
+// newUploadCmd creates the command to upload files as assets of a release, along with a file of their checksums.
+func newUploadCmd(cfg config.Config, service service, utilities utilities) *cli.Command {
+	var authToken, repoOwner, repository, tag string
+	var assetPatterns cli.StringSlice
+	var replace, skipChecksums bool
+
+	flagBuilder := flag.New(cfg)
+
+	uploadCmd := cli.Command{
+		Name:  "upload",
+		Usage: "Uploads the files that match the glob patterns as assets of a release, along with a SHA256SUMS file of their checksums.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendTagFlag(&tag).
+			AppendAssetFlag(&assetPatterns).
+			AppendReplaceFlag(&replace).
+			AppendSkipChecksumsFlag(&skipChecksums).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			files, err := utilities.GetAssetFiles(assetPatterns.Value())
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			var checksums string
+			if !skipChecksums {
+				checksums, err = utilities.BuildChecksums(files)
+				if err != nil {
+					fmt.Printf("Failed to calculate the checksums with error : %v\n", err)
+					return err
+				}
+			}
+
+			release, err := service.GetReleaseByTag(authToken, repoOwner, repository, tag)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			var uploadedAssets, skippedAssets []string
+			upload := func(name string, content io.Reader, size int64, replace bool) error {
+				reader := &progressReader{
+					reader: content,
+					onProgress: func(read int64) {
+						spinLoader.Lock()
+						spinLoader.Suffix = fmt.Sprintf(" Uploading %v : %v%%", name, percentage(read, size))
+						spinLoader.Unlock()
+					},
+				}
+
+				_, uploaded, err := service.UploadReleaseAsset(authToken, repoOwner, repository, release, name, reader, size, replace)
+				if err != nil {
+					return fmt.Errorf("failed to upload %v : %v", name, err)
+				}
+
+				if uploaded {
+					uploadedAssets = append(uploadedAssets, name)
+				} else {
+					skippedAssets = append(skippedAssets, name)
+				}
+
+				return nil
+			}
+			uploadAsset := func(name string, content io.Reader, size int64) error {
+				return upload(name, content, size, replace)
+			}
+
+			for _, f := range files {
+				err = uploadFile(f, uploadAsset)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+			}
+
+			// The checksums are replaced whenever any asset got uploaded, so that they do not get outdated.
+			if !skipChecksums {
+				err = upload(domain.ReleaseChecksumFile, strings.NewReader(checksums), int64(len(checksums)), replace || len(uploadedAssets) > 0)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+			}
+
+			spinLoader.Stop()
+
+			for _, a := range uploadedAssets {
+				fmt.Printf("Uploaded %v\n", a)
+			}
+
+			for _, a := range skippedAssets {
+				fmt.Printf("Skipped %v, as the release already has an asset with the same name\n", a)
+			}
+
+			fmt.Printf("Release: '%v' %v\n", release.Name, release.HtmlUrl)
+
+			return nil
+		},
+	}
+
+	return &uploadCmd
+}
+
+// uploadFile opens the file and uploads its content with the base name of the file.
+func uploadFile(path string, uploadAsset func(name string, content io.Reader, size int64) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	return uploadAsset(filepath.Base(path), file, info.Size())
+}
+
+// percentage calculates the percentage of the part out of the total.
+func percentage(part, total int64) int64 {
+	if total == 0 {
+		return 100
+	}
+
+	return part * 100 / total
+}
+
+// applyReleaseUpdate updates the release after the confirmation of the user, unless it is forced.
+func applyReleaseUpdate(authToken, repoOwner, repository string, release domain.Release, releaseUpdate domain.ReleaseUpdate, force bool, message string, service service, utilities utilities) error {
+	confirmed, err := utilities.Confirm(force, message)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	if !confirmed {
+		return nil
+	}
+
+	updatedRelease, err := service.UpdateRelease(authToken, repoOwner, repository, release.ID, releaseUpdate)
+	if err != nil {
+		fmt.Printf("Failed to update release with error : %v\n", err)
+		return err
+	}
+
+	fmt.Printf("Updated release: '%v' %v\n", updatedRelease.Name, updatedRelease.HtmlUrl)
+
+	return nil
+}
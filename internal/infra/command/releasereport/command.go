@@ -22,18 +22,29 @@ const (
     versionPatternWithServiceInitials = "^(v[\\d]+.[\\d]+.[\\d]+-(\\w){numOfInitialLetters,numOfInitialLetters})$"
 )
 
+type userReposService interface {
+    GetRepositoryNames(authToken, owner string, repositories []string, selector domain.RepositorySelector) ([]string, error)
+}
+
 type service interface {
     GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
 }
 
 type tablePrinter interface {
     PrintReleaseReport(releaseReport domain.ReleaseReport, captionText string)
+    PrintReleaseReportBreakdown(repositoryReports []domain.RepositoryReleaseReport, releaseReport domain.ReleaseReport, captionText string)
 }
 
-// NewCmd creates a new command to generate report for release on a repo.
-func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.Command {
-    var authToken, repoOwner, repository string
+type utilities interface {
+    RunConcurrently(count int, fn func(idx int) error) error
+}
+
+// NewCmd creates a new command to generate report for release on one or more repos.
+func NewCmd(cfg config.Config, userReposService userReposService, service service, tablePrinter tablePrinter, utilities utilities) *cli.Command {
+    var authToken, repoOwner string
     var startDateStr, endDateStr string
+    var repositories cli.StringSlice
+    var repositorySelector flag.RepositorySelector
 
     var enableDefaultVersionPattern bool
     var enableDefaultVersionPatternWithServiceInitials bool
@@ -45,11 +56,12 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.
     releaseReportCmd := cli.Command{
         Name:    "release-report",
         Aliases: []string{"r"},
-        Usage:   "Retrieves the releases that were published and/or created within a time range for a repository and prints a report based on them.",
+        Usage:   "Retrieves the releases that were published and/or created within a time range for one or more repositories and prints a report based on them.",
         Flags: flagBuilder.
             AppendAuthFlag(&authToken).
-            AppendOwnerFlag(&repoOwner).
-            AppendRepositoryFlag(&repository).
+            AppendOwnerFlag(&repoOwner, false).
+            AppendRepositoryNamesFlag(&repositories).
+            AppendRepositorySelectorFlags(&repositorySelector).
             AppendStartDateFlag(&startDateStr, false).
             AppendEndDateFlag(&endDateStr, false).
             AppendDefaultVersionPatternFlag(&enableDefaultVersionPattern, defaultVersionPattern).
@@ -73,42 +85,36 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.
                 return endDateParseErr
             }
 
+            repoNames, err := userReposService.GetRepositoryNames(authToken, repoOwner, repositories.Value(), repositorySelector.Get())
+            if err != nil {
+                fmt.Println(err)
+                return err
+            }
+
             spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+            spinLoader.Start()
 
-            var releaseList []domain.Release
-            currentPage := 1
-            for {
-                spinLoader.Start()
-                currentReleaseListPage, err := service.GetReleaseList(authToken, repoOwner, repository, 10, currentPage)
+            releaseLists := make([][]domain.Release, len(repoNames))
+            err = utilities.RunConcurrently(len(repoNames), func(idx int) error {
+                releaseList, err := getReleasesOfRepository(service, authToken, repoNames[idx], startDate, endDate)
                 if err != nil {
-                    spinLoader.Stop()
-                    fmt.Println(err)
                     return err
                 }
 
-                if len(currentReleaseListPage) == 0 {
-                    spinLoader.Stop()
-                    break
-                }
+                releaseLists[idx] = releaseList
+                return nil
+            })
 
-                needToBreak := false
-                for _, rel := range currentReleaseListPage {
-                    if (rel.CreatedAt.After(startDate) && rel.CreatedAt.Before(endDate)) || (rel.PublishedAt.After(startDate) && rel.PublishedAt.Before(endDate)) {
-                        releaseList = append(releaseList, rel)
-                        continue
-                    }
+            spinLoader.Stop()
 
-                    if rel.CreatedAt.Before(startDate) && rel.PublishedAt.Before(startDate) {
-                        needToBreak = true
-                    }
-                }
-
-                if needToBreak {
-                    spinLoader.Stop()
-                    break
-                }
+            if err != nil {
+                fmt.Println(err)
+                return err
+            }
 
-                currentPage++
+            var releaseList []domain.Release
+            for _, rl := range releaseLists {
+                releaseList = append(releaseList, rl...)
             }
 
             validDefaultReleaseVersion := regexp.MustCompile(defaultVersionPattern)
@@ -130,21 +136,7 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.
                             }
                         }
 
-                        if rel.Draft {
-                            releaseReportMap[serviceInitials].NumberOfDraftReleases++
-                        }
-
-                        if rel.PreRelease {
-                            releaseReportMap[serviceInitials].NumberOfDraftReleases++
-                        }
-
-                        if rel.CreatedAt.After(startDate) && rel.CreatedAt.Before(endDate) {
-                            releaseReportMap[serviceInitials].NumberOfReleasesCreated++
-                        }
-
-                        if rel.PublishedAt.After(startDate) && rel.PublishedAt.Before(endDate) {
-                            releaseReportMap[serviceInitials].NumberOfReleasesPublished++
-                        }
+                        addReleaseToReport(releaseReportMap[serviceInitials], rel, startDate, endDate)
                     }
                 }
 
@@ -155,44 +147,28 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.
                     tablePrinter.PrintReleaseReport(*releaseReport, captionText)
                 }
             } else {
-                releaseReport := &domain.ReleaseReport{
-                    NumberOfDraftReleases:     0,
-                    NumberOfReleasesCreated:   0,
-                    NumberOfReleasesPublished: 0,
-                    CreatedToPublishedRatio:   0.0,
+                var versionPattern *regexp.Regexp
+                captionText := ""
+                if enableDefaultVersionPattern {
+                    versionPattern = validDefaultReleaseVersion
+                    captionText = fmt.Sprintf(captionTextPattern, defaultVersionPattern)
                 }
 
-                for _, rel := range releaseList {
-                    if enableDefaultVersionPattern {
-                        if !validDefaultReleaseVersion.MatchString(rel.TagName) {
-                            continue
-                        }
-                    }
-
-                    if rel.Draft {
-                        releaseReport.NumberOfDraftReleases++
-                    }
-
-                    if rel.PreRelease {
-                        releaseReport.NumberOfDraftReleases++
-                    }
+                releaseReport := buildReleaseReport(releaseList, startDate, endDate, versionPattern)
 
-                    if rel.CreatedAt.After(startDate) && rel.CreatedAt.Before(endDate) {
-                        releaseReport.NumberOfReleasesCreated++
+                if len(repoNames) > 1 {
+                    var repositoryReports []domain.RepositoryReleaseReport
+                    for idx, repoFullName := range repoNames {
+                        repositoryReports = append(repositoryReports, domain.RepositoryReleaseReport{
+                            Repository:    repoFullName,
+                            ReleaseReport: buildReleaseReport(releaseLists[idx], startDate, endDate, versionPattern),
+                        })
                     }
 
-                    if rel.PublishedAt.After(startDate) && rel.PublishedAt.Before(endDate) {
-                        releaseReport.NumberOfReleasesPublished++
-                    }
+                    tablePrinter.PrintReleaseReportBreakdown(repositoryReports, releaseReport, captionText)
+                } else {
+                    tablePrinter.PrintReleaseReport(releaseReport, captionText)
                 }
-
-                releaseReport.CalculateRatioFields()
-
-                captionText := ""
-                if enableDefaultVersionPattern {
-                    captionText = fmt.Sprintf(captionTextPattern, defaultVersionPattern)
-                }
-                tablePrinter.PrintReleaseReport(*releaseReport, captionText)
             }
 
             return nil
@@ -201,3 +177,83 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter) *cli.
 
     return &releaseReportCmd
 }
+
+// getReleasesOfRepository retrieves the releases of a repository that were created or published within the time range.
+func getReleasesOfRepository(service service, authToken, repoFullName string, startDate, endDate time.Time) ([]domain.Release, error) {
+    details := strings.Split(repoFullName, "/")
+
+    var releaseList []domain.Release
+    currentPage := 1
+    for {
+        currentReleaseListPage, err := service.GetReleaseList(authToken, details[0], details[1], 10, currentPage)
+        if err != nil {
+            return []domain.Release{}, err
+        }
+
+        if len(currentReleaseListPage) == 0 {
+            break
+        }
+
+        needToBreak := false
+        for _, rel := range currentReleaseListPage {
+            if (rel.CreatedAt.After(startDate) && rel.CreatedAt.Before(endDate)) || (rel.PublishedAt.After(startDate) && rel.PublishedAt.Before(endDate)) {
+                releaseList = append(releaseList, rel)
+                continue
+            }
+
+            if rel.CreatedAt.Before(startDate) && rel.PublishedAt.Before(startDate) {
+                needToBreak = true
+            }
+        }
+
+        if needToBreak {
+            break
+        }
+
+        currentPage++
+    }
+
+    return releaseList, nil
+}
+
+// buildReleaseReport prepares the release report of the provided releases. In case a version pattern is provided, only
+// the releases with a matching tag are taken into account.
+func buildReleaseReport(releaseList []domain.Release, startDate, endDate time.Time, versionPattern *regexp.Regexp) domain.ReleaseReport {
+    releaseReport := &domain.ReleaseReport{
+        NumberOfDraftReleases:     0,
+        NumberOfReleasesCreated:   0,
+        NumberOfReleasesPublished: 0,
+        CreatedToPublishedRatio:   0.0,
+    }
+
+    for _, rel := range releaseList {
+        if versionPattern != nil && !versionPattern.MatchString(rel.TagName) {
+            continue
+        }
+
+        addReleaseToReport(releaseReport, rel, startDate, endDate)
+    }
+
+    releaseReport.CalculateRatioFields()
+
+    return *releaseReport
+}
+
+// addReleaseToReport updates the counters of the release report based on the details of the release.
+func addReleaseToReport(releaseReport *domain.ReleaseReport, rel domain.Release, startDate, endDate time.Time) {
+    if rel.Draft {
+        releaseReport.NumberOfDraftReleases++
+    }
+
+    if rel.PreRelease {
+        releaseReport.NumberOfPreReleases++
+    }
+
+    if rel.CreatedAt.After(startDate) && rel.CreatedAt.Before(endDate) {
+        releaseReport.NumberOfReleasesCreated++
+    }
+
+    if rel.PublishedAt.After(startDate) && rel.PublishedAt.Before(endDate) {
+        releaseReport.NumberOfReleasesPublished++
+    }
+}
@@ -0,0 +1,133 @@
+package releasereport
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+type fakeService struct {
+	pages       [][]domain.Release
+	err         error
+	pageNumbers []int
+}
+
+func (s *fakeService) GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error) {
+	s.pageNumbers = append(s.pageNumbers, pageNumber)
+	if s.err != nil {
+		return []domain.Release{}, s.err
+	}
+
+	if pageNumber > len(s.pages) {
+		return []domain.Release{}, nil
+	}
+
+	return s.pages[pageNumber-1], nil
+}
+
+func TestGetReleasesOfRepository(t *testing.T) {
+	startDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	inRange := time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)
+	afterRange := time.Date(2021, 2, 15, 0, 0, 0, 0, time.UTC)
+	beforeRange := time.Date(2020, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Releases created or published within the time range", func(t *testing.T) {
+		service := &fakeService{
+			pages: [][]domain.Release{
+				{
+					{TagName: "v1.3.0", CreatedAt: afterRange, PublishedAt: afterRange},
+					{TagName: "v1.2.0", CreatedAt: inRange, PublishedAt: afterRange},
+				},
+				{
+					{TagName: "v1.1.0", CreatedAt: beforeRange, PublishedAt: inRange},
+					{TagName: "v1.0.0", CreatedAt: beforeRange, PublishedAt: beforeRange},
+				},
+				{
+					{TagName: "v0.9.0", CreatedAt: beforeRange, PublishedAt: beforeRange},
+				},
+			},
+		}
+
+		actual, err := getReleasesOfRepository(service, "token", "o/r", startDate, endDate)
+
+		expected := []domain.Release{
+			{TagName: "v1.2.0", CreatedAt: inRange, PublishedAt: afterRange},
+			{TagName: "v1.1.0", CreatedAt: beforeRange, PublishedAt: inRange},
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as releases, but got '%v'", expected, actual)
+		}
+		if !reflect.DeepEqual([]int{1, 2}, service.pageNumbers) {
+			t.Errorf("Expected to get '%v' as retrieved pages, but got '%v'", []int{1, 2}, service.pageNumbers)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Failure to retrieve the releases - expecting an error", func(t *testing.T) {
+		service := &fakeService{err: errors.New("failure")}
+
+		_, err := getReleasesOfRepository(service, "token", "o/r", startDate, endDate)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestBuildReleaseReport(t *testing.T) {
+	startDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	inRange := time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)
+	beforeRange := time.Date(2020, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	releaseList := []domain.Release{
+		{TagName: "v1.2.0", CreatedAt: inRange, PublishedAt: inRange},
+		{TagName: "v1.2.0-rc.1", PreRelease: true, CreatedAt: inRange, PublishedAt: inRange},
+		{TagName: "v1.3.0", Draft: true, CreatedAt: inRange},
+		{TagName: "v1.1.0", CreatedAt: beforeRange, PublishedAt: inRange},
+	}
+
+	testCases := map[string]struct {
+		versionPattern *regexp.Regexp
+		expected       domain.ReleaseReport
+	}{
+		"All the releases": {
+			versionPattern: nil,
+			expected: domain.ReleaseReport{
+				NumberOfDraftReleases:     1,
+				NumberOfPreReleases:       1,
+				NumberOfReleasesCreated:   3,
+				NumberOfReleasesPublished: 3,
+				CreatedToPublishedRatio:   1,
+			},
+		},
+		"Only the releases that match the version pattern": {
+			versionPattern: regexp.MustCompile(defaultVersionPattern),
+			expected: domain.ReleaseReport{
+				NumberOfDraftReleases:     1,
+				NumberOfPreReleases:       0,
+				NumberOfReleasesCreated:   2,
+				NumberOfReleasesPublished: 2,
+				CreatedToPublishedRatio:   1,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := buildReleaseReport(releaseList, startDate, endDate, tc.versionPattern)
+
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%+v' as release report, but got '%+v'", tc.expected, actual)
+			}
+		})
+	}
+}
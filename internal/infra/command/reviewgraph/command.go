@@ -0,0 +1,192 @@
+package reviewgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	defaultPageSize = 50
+	allPrState      = "all"
+	pendingState    = "PENDING"
+
+	tableFormat   = "table"
+	dotFormat     = "dot"
+	mermaidFormat = "mermaid"
+	jsonFormat    = "json"
+)
+
+type userReposService interface {
+	GetRepositoryNames(authToken, owner string, repositories []string, selector domain.RepositorySelector) ([]string, error)
+}
+
+type pullRequestService interface {
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
+	GetPullRequestReviews(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error)
+	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+}
+
+type automationService interface {
+	IsAutomatedUser(user domain.User) bool
+}
+
+type tablePrinter interface {
+	PrintReviewGraph(reviewGraph domain.ReviewGraph)
+	PrintReviewGraphDot(reviewGraph domain.ReviewGraph)
+	PrintReviewGraphMermaid(reviewGraph domain.ReviewGraph)
+}
+
+// NewCmd creates a new command to build the graph of who reviews whom across repositories.
+func NewCmd(cfg config.Config, userReposService userReposService, pullRequestService pullRequestService, automationService automationService, tablePrinter tablePrinter) *cli.Command {
+	var authToken, baseBranch, startDateStr, endDateStr, format string
+	var repositories cli.StringSlice
+	var repositorySelector flag.RepositorySelector
+	var includeBots bool
+
+	flagBuilder := flag.New(cfg)
+
+	reviewGraphCmd := cli.Command{
+		Name:    "review-graph",
+		Aliases: []string{"rg"},
+		Usage:   "Retrieves the pull requests of the provided repositories that have been created during a specific time period and builds the graph of which reviewers review the pull requests of each author.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendRepositoriesFlag(&repositories, false).
+			AppendRepositorySelectorFlags(&repositorySelector).
+			AppendBaseFlag(&baseBranch).
+			AppendStartDateFlag(&startDateStr, true).
+			AppendEndDateFlag(&endDateStr, true).
+			AppendIncludeBotsFlag(&includeBots).
+			AppendFormatFlag(&format, []string{tableFormat, dotFormat, mermaidFormat, jsonFormat}).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			switch format {
+			case tableFormat, dotFormat, mermaidFormat, jsonFormat:
+			default:
+				err := fmt.Errorf("invalid format %q, expected one of [%v]", format, strings.Join([]string{tableFormat, dotFormat, mermaidFormat, jsonFormat}, "|"))
+				fmt.Println(err)
+				return err
+			}
+
+			startDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 00:00:00", startDateStr))
+			if err != nil {
+				fmt.Printf("Failed to parse date %q with error : %v\n", startDateStr, err)
+				return err
+			}
+
+			endDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 23:59:59", endDateStr))
+			if err != nil {
+				fmt.Printf("Failed to parse date %q with error : %v\n", endDateStr, err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			var pullRequestReviewers []domain.PullRequestReviewers
+			repoNames, err := userReposService.GetRepositoryNames(authToken, "", repositories.Value(), repositorySelector.Get())
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			for _, repoFullName := range repoNames {
+				details := strings.Split(repoFullName, "/")
+
+				currentPage := 1
+				shallContinue := true
+				for shallContinue {
+					prResp, err := pullRequestService.GetPullRequestsOfRepository(authToken, details[0], details[1], baseBranch, allPrState, defaultPageSize, currentPage)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Println(err)
+						return err
+					}
+
+					pullRequests, _ := pullRequestService.FilterAutomatedPullRequests(prResp.PullRequests, includeBots)
+					for _, pr := range pullRequests {
+						if pr.CreatedAt.Before(startDate) {
+							shallContinue = false
+							continue
+						}
+
+						if pr.CreatedAt.After(endDate) {
+							continue
+						}
+
+						reviews, err := pullRequestService.GetPullRequestReviews(authToken, details[0], details[1], pr.Number)
+						if err != nil {
+							spinLoader.Stop()
+							fmt.Printf("Failed to get the reviews of pull request #%v of %v with error : %v\n", pr.Number, repoFullName, err)
+							return err
+						}
+
+						prReviewers := domain.PullRequestReviewers{
+							Repository: repoFullName,
+							Number:     pr.Number,
+							Author:     pr.Creator.Username,
+							Reviewers:  []string{},
+						}
+
+						distinctReviewers := make(map[string]bool)
+						for _, r := range reviews {
+							if r.State == pendingState || distinctReviewers[r.User.Username] {
+								continue
+							}
+
+							if !includeBots && automationService.IsAutomatedUser(r.User) {
+								continue
+							}
+
+							distinctReviewers[r.User.Username] = true
+							prReviewers.Reviewers = append(prReviewers.Reviewers, r.User.Username)
+						}
+
+						pullRequestReviewers = append(pullRequestReviewers, prReviewers)
+					}
+
+					if len(prResp.PullRequests) < defaultPageSize {
+						break
+					}
+
+					currentPage++
+				}
+			}
+
+			reviewGraph := metrics.BuildReviewGraph(pullRequestReviewers)
+
+			spinLoader.Stop()
+
+			switch format {
+			case dotFormat:
+				tablePrinter.PrintReviewGraphDot(reviewGraph)
+			case mermaidFormat:
+				tablePrinter.PrintReviewGraphMermaid(reviewGraph)
+			case jsonFormat:
+				jsonBytes, err := json.Marshal(reviewGraph)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			default:
+				tablePrinter.PrintReviewGraph(reviewGraph)
+			}
+
+			return nil
+		},
+	}
+
+	return &reviewGraphCmd
+}
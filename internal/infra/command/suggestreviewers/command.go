@@ -0,0 +1,312 @@
+package suggestreviewers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/eujoy/gitpr/internal/app/infra/codeowners"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/flag"
+	"github.com/eujoy/gitpr/pkg/metrics"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	defaultPageSize = 100
+	openPrState     = "open"
+)
+
+type pullRequestService interface {
+	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error)
+	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+	RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error
+}
+
+type repositoryService interface {
+	GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
+}
+
+type teamsService interface {
+	GetTeamMembers(authToken, teamName string) ([]string, error)
+}
+
+type automationService interface {
+	IsAutomatedUser(user domain.User) bool
+}
+
+type codeOwnersService interface {
+	GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error)
+	GetFileOwners(codeOwners domain.CodeOwners, filename string) []string
+	GetOwnerMembers(authToken, owner string) ([]string, error)
+}
+
+type tablePrinter interface {
+	PrintReviewerSuggestion(reviewerSuggestion domain.ReviewerSuggestion)
+}
+
+// NewCmd creates a new command to suggest the reviewers of a pull request.
+func NewCmd(cfg config.Config, pullRequestService pullRequestService, repositoryService repositoryService, teamsService teamsService, automationService automationService, codeOwnersService codeOwnersService, tablePrinter tablePrinter) *cli.Command {
+	var authToken, repoOwner, repository, headBranch, teamName string
+	var pullRequestNumber, months, top, request int
+	var printJson, includeBots bool
+
+	flagBuilder := flag.New(cfg)
+
+	suggestReviewersCmd := cli.Command{
+		Name:    "suggest-reviewers",
+		Aliases: []string{"sr"},
+		Usage:   "Ranks the candidate reviewers of a pull request based on the ownership and the recent authorship of the modified files, the open reviews of each candidate and the team membership, and optionally requests the review from the top ones.",
+		Flags: flagBuilder.
+			AppendAuthFlag(&authToken).
+			AppendOwnerFlag(&repoOwner, true).
+			AppendRepositoryFlag(&repository).
+			AppendPullRequestNumberFlag(&pullRequestNumber).
+			AppendHeadBranchFlag(&headBranch).
+			AppendTeamFlag(&teamName).
+			AppendReviewerMonthsFlag(&months).
+			AppendTopFlag(&top).
+			AppendRequestFlag(&request).
+			AppendIncludeBotsFlag(&includeBots).
+			AppendPrintJsonFlag(&printJson).
+			GetFlags(),
+		Action: func(c *cli.Context) error {
+			if pullRequestNumber == 0 && headBranch == "" {
+				err := errors.New("either the number or the branch of the pull request needs to be provided")
+				fmt.Println(err)
+				return err
+			}
+
+			spinLoader := spinner.New(spinner.CharSets[cfg.Spinner.Type], cfg.Spinner.Time*time.Millisecond, spinner.WithHiddenCursor(cfg.Spinner.HideCursor))
+			spinLoader.Start()
+
+			var openPullRequests []domain.PullRequest
+			currentPage := 1
+			for {
+				prResp, err := pullRequestService.GetPullRequestsOfRepository(authToken, repoOwner, repository, "", openPrState, defaultPageSize, currentPage)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				openPullRequests = append(openPullRequests, prResp.PullRequests...)
+
+				if len(prResp.PullRequests) < defaultPageSize {
+					break
+				}
+
+				currentPage++
+			}
+
+			var pullRequest domain.PullRequest
+			for _, pr := range openPullRequests {
+				if (pullRequestNumber != 0 && pr.Number == pullRequestNumber) || (pullRequestNumber == 0 && pr.Head.Ref == headBranch) {
+					pullRequest = pr
+					break
+				}
+			}
+
+			if pullRequest.Number == 0 {
+				if pullRequestNumber == 0 {
+					spinLoader.Stop()
+					err := fmt.Errorf("no open pull request found for branch %q", headBranch)
+					fmt.Println(err)
+					return err
+				}
+
+				pr, err := pullRequestService.GetPullRequestsDetails(authToken, repoOwner, repository, pullRequestNumber)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+				pullRequest = pr
+			}
+
+			files, err := pullRequestService.GetPullRequestFiles(authToken, repoOwner, repository, pullRequest.Number)
+			if err != nil {
+				spinLoader.Stop()
+				fmt.Printf("Failed to get the files of pull request #%v with error : %v\n", pullRequest.Number, err)
+				return err
+			}
+
+			sort.SliceStable(files, func(i, j int) bool {
+				return files[i].Changes > files[j].Changes
+			})
+			if cfg.Reviewers.MaxFiles > 0 && len(files) > cfg.Reviewers.MaxFiles {
+				files = files[:cfg.Reviewers.MaxFiles]
+			}
+
+			// The ownership and the history of the files are taken from the base branch of the pull request. The
+			// repositories without a CODEOWNERS file are ranked without the ownership of the files.
+			baseBranch := pullRequest.Base.Ref
+
+			ownedFiles := make(map[string]int)
+			codeOwners, err := codeOwnersService.GetCodeOwners(authToken, repoOwner, repository, baseBranch)
+			if err != nil && !errors.Is(err, codeowners.ErrNoCodeOwners) {
+				spinLoader.Stop()
+				fmt.Println(err)
+				return err
+			}
+
+			for _, f := range files {
+				fileOwners := make(map[string]bool)
+				for _, owner := range codeOwnersService.GetFileOwners(codeOwners, f.Filename) {
+					members, err := codeOwnersService.GetOwnerMembers(authToken, owner)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Printf("Failed to get the members of owner %v with error : %v\n", owner, err)
+						return err
+					}
+
+					for _, m := range members {
+						fileOwners[m] = true
+					}
+				}
+
+				for m := range fileOwners {
+					ownedFiles[m]++
+				}
+			}
+
+			authoredFiles := make(map[string]int)
+			recentCommits := make(map[string]int)
+			since := time.Now().AddDate(0, -months, 0)
+			for _, f := range files {
+				fileAuthors := make(map[string]int)
+				currentPage := 1
+				for {
+					commits, err := repositoryService.GetFileCommitList(authToken, repoOwner, repository, baseBranch, f.Filename, since, defaultPageSize, currentPage)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Printf("Failed to get the commits of file %v with error : %v\n", f.Filename, err)
+						return err
+					}
+
+					for _, commitItem := range commits {
+						if commitItem.Author.Username == "" || (!includeBots && automationService.IsAutomatedUser(commitItem.Author)) {
+							continue
+						}
+
+						fileAuthors[commitItem.Author.Username]++
+					}
+
+					if len(commits) < defaultPageSize {
+						break
+					}
+
+					currentPage++
+				}
+
+				for author, commitCount := range fileAuthors {
+					authoredFiles[author]++
+					recentCommits[author] += commitCount
+				}
+			}
+
+			teamMembers := make(map[string]bool)
+			if teamName != "" {
+				members, err := teamsService.GetTeamMembers(authToken, teamName)
+				if err != nil {
+					spinLoader.Stop()
+					fmt.Println(err)
+					return err
+				}
+
+				for _, m := range members {
+					teamMembers[m] = true
+				}
+			}
+
+			usernames := make(map[string]bool)
+			for _, signal := range []map[string]int{ownedFiles, authoredFiles} {
+				for u := range signal {
+					usernames[u] = true
+				}
+			}
+			for u := range teamMembers {
+				usernames[u] = true
+			}
+
+			reviewLoad := metrics.GetOpenReviewLoad(openPullRequests, pullRequest.Number)
+
+			var candidates []domain.ReviewerCandidate
+			for u := range usernames {
+				if u == pullRequest.Creator.Username || (!includeBots && automationService.IsAutomatedUser(domain.User{Username: u})) {
+					continue
+				}
+
+				candidates = append(candidates, domain.ReviewerCandidate{
+					Username:      u,
+					OwnedFiles:    ownedFiles[u],
+					AuthoredFiles: authoredFiles[u],
+					RecentCommits: recentCommits[u],
+					OpenReviews:   reviewLoad[u],
+					IsTeamMember:  teamMembers[u],
+				})
+			}
+
+			weights := domain.ReviewerWeights{
+				Ownership:  cfg.Reviewers.OwnershipWeight,
+				Authorship: cfg.Reviewers.AuthorshipWeight,
+				Team:       cfg.Reviewers.TeamWeight,
+				ReviewLoad: cfg.Reviewers.ReviewLoadPenalty,
+			}
+
+			reviewerSuggestion := domain.ReviewerSuggestion{
+				Number:     pullRequest.Number,
+				Title:      pullRequest.Title,
+				Author:     pullRequest.Creator.Username,
+				HtmlUrl:    pullRequest.HtmlUrl,
+				Files:      len(files),
+				Candidates: metrics.RankReviewerCandidates(candidates, len(files), weights),
+				Requested:  []string{},
+			}
+
+			if request > 0 {
+				for idx := 0; idx < request && idx < len(reviewerSuggestion.Candidates); idx++ {
+					reviewerSuggestion.Requested = append(reviewerSuggestion.Requested, reviewerSuggestion.Candidates[idx].Username)
+				}
+
+				if len(reviewerSuggestion.Requested) > 0 {
+					err := pullRequestService.RequestReviewers(authToken, repoOwner, repository, pullRequest.Number, reviewerSuggestion.Requested)
+					if err != nil {
+						spinLoader.Stop()
+						fmt.Printf("Failed to request the review of pull request #%v with error : %v\n", pullRequest.Number, err)
+						return err
+					}
+				}
+			}
+
+			if top > 0 && len(reviewerSuggestion.Candidates) > top {
+				reviewerSuggestion.Candidates = reviewerSuggestion.Candidates[:top]
+			}
+
+			spinLoader.Stop()
+
+			if printJson {
+				jsonBytes, err := json.Marshal(reviewerSuggestion)
+				if err != nil {
+					fmt.Printf("Failed to generate json with error : %v", err)
+					return err
+				}
+
+				fmt.Printf("%s\n", string(jsonBytes))
+			} else {
+				fmt.Printf("Pull request : #%v %v (%v)\n", reviewerSuggestion.Number, reviewerSuggestion.Title, reviewerSuggestion.HtmlUrl)
+				fmt.Println()
+				tablePrinter.PrintReviewerSuggestion(reviewerSuggestion)
+			}
+
+			return nil
+		},
+	}
+
+	return &suggestReviewersCmd
+}
@@ -0,0 +1,163 @@
+package suggestreviewers_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/app/infra/codeowners"
+	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/internal/infra/command/suggestreviewers"
+	"github.com/urfave/cli/v2"
+)
+
+type pullRequestService struct {
+	requested []string
+}
+
+func (s *pullRequestService) GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error) {
+	return domain.PullRequest{}, errors.New("not found")
+}
+
+func (s *pullRequestService) GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.CommitFile, error) {
+	return []domain.CommitFile{{Filename: "main.go", Changes: 10}}, nil
+}
+
+func (s *pullRequestService) GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error) {
+	return domain.RepoPullRequestsResponse{
+		PullRequests: []domain.PullRequest{
+			{Number: 1, Creator: domain.User{Username: "author"}, Head: domain.PullRequestBranch{Ref: "feature"}, Base: domain.PullRequestBranch{Ref: "main"}},
+			{Number: 2, Creator: domain.User{Username: "other"}, ReviewStates: map[string]string{"owner": "PENDING"}},
+		},
+	}, nil
+}
+
+func (s *pullRequestService) RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error {
+	s.requested = reviewers
+	return nil
+}
+
+type repositoryService struct {
+	branches []string
+}
+
+func (s *repositoryService) GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	s.branches = append(s.branches, branch)
+	return []domain.Commit{
+		{Author: domain.User{Username: "developer"}},
+		{Author: domain.User{Username: "author"}},
+		{Author: domain.User{Username: "renovate[bot]"}},
+	}, nil
+}
+
+type teamsService struct{}
+
+func (s *teamsService) GetTeamMembers(authToken, teamName string) ([]string, error) {
+	return []string{}, nil
+}
+
+type automationService struct{}
+
+func (s *automationService) IsAutomatedUser(user domain.User) bool {
+	return user.Username == "renovate[bot]"
+}
+
+type codeOwnersService struct {
+	err  error
+	refs []string
+}
+
+func (s *codeOwnersService) GetCodeOwners(authToken, repoOwner, repository, ref string) (domain.CodeOwners, error) {
+	s.refs = append(s.refs, ref)
+	if s.err != nil {
+		return domain.CodeOwners{}, s.err
+	}
+
+	return domain.CodeOwners{Path: "CODEOWNERS"}, nil
+}
+
+func (s *codeOwnersService) GetFileOwners(codeOwners domain.CodeOwners, filename string) []string {
+	if codeOwners.Path == "" {
+		return nil
+	}
+
+	return []string{"@org/team"}
+}
+
+func (s *codeOwnersService) GetOwnerMembers(authToken, owner string) ([]string, error) {
+	return []string{"owner"}, nil
+}
+
+type tablePrinter struct{}
+
+func (p *tablePrinter) PrintReviewerSuggestion(reviewerSuggestion domain.ReviewerSuggestion) {}
+
+func TestNewCmd(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Reviewers.Months = 6
+	cfg.Reviewers.OwnershipWeight = 3
+	cfg.Reviewers.AuthorshipWeight = 2
+	cfg.Reviewers.ReviewLoadPenalty = 0.5
+
+	testCases := map[string]struct {
+		args          []string
+		codeOwnersErr error
+		expected      []string
+		expectedErr   bool
+	}{
+		"Request the review from the top ranked candidates": {
+			args:     []string{"--owner", "o", "--repository", "r", "--branch", "feature", "--request", "2"},
+			expected: []string{"owner", "developer"},
+		},
+		"Only list the candidates": {
+			args:     []string{"--owner", "o", "--repository", "r", "--number", "1"},
+			expected: nil,
+		},
+		"Neither the number nor the branch is provided - expecting an error": {
+			args:        []string{"--owner", "o", "--repository", "r", "--request", "1"},
+			expected:    nil,
+			expectedErr: true,
+		},
+		"Repository without a CODEOWNERS file": {
+			args:          []string{"--owner", "o", "--repository", "r", "--branch", "feature", "--request", "2"},
+			codeOwnersErr: fmt.Errorf("%w in o/r", codeowners.ErrNoCodeOwners),
+			expected:      []string{"developer"},
+		},
+		"Failure to retrieve the code owners - expecting an error": {
+			args:          []string{"--owner", "o", "--repository", "r", "--number", "1", "--request", "1"},
+			codeOwnersErr: errors.New("failure"),
+			expected:      nil,
+			expectedErr:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			prService := &pullRequestService{}
+			repoService := &repositoryService{}
+			ownersService := &codeOwnersService{err: tc.codeOwnersErr}
+			cmd := suggestreviewers.NewCmd(cfg, prService, repoService, &teamsService{}, &automationService{}, ownersService, &tablePrinter{})
+
+			app := &cli.App{Commands: []*cli.Command{cmd}}
+			err := app.Run(append([]string{"gitpr", "suggest-reviewers"}, tc.args...))
+
+			if !reflect.DeepEqual(tc.expected, prService.requested) {
+				t.Errorf("Expected to get '%v' as requested reviewers, but got '%v'", tc.expected, prService.requested)
+			}
+			for _, ref := range append(ownersService.refs, repoService.branches...) {
+				if ref != "main" {
+					t.Errorf("Expected to get '%v' as base branch, but got '%v'", "main", ref)
+				}
+			}
+			if tc.expectedErr && err == nil {
+				t.Errorf("Expected to get an error, but got nil")
+			}
+			if !tc.expectedErr && err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+		})
+	}
+}
@@ -13,16 +13,24 @@ import (
 )
 
 type userReposService interface {
-	GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error)
+	SelectRepositories(authToken string, selector domain.RepositorySelector) ([]domain.Repository, error)
 }
 
 type pullRequestService interface {
+	FilterAutomatedPullRequests(pullRequests []domain.PullRequest, includeBots bool) ([]domain.PullRequest, []domain.PullRequest)
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
 }
 
+type teamsService interface {
+	GetFilterMembers(authToken, teamName, teamRole string) ([]string, error)
+	FilterPullRequests(pullRequests []domain.PullRequest, members []string, teamRole string) []domain.PullRequest
+}
+
 // NewCmd creates a new command to display the details retrieved as widgets in terminal.
-func NewCmd(cfg config.Config, userReposService userReposService, pullRequestService pullRequestService) *cli.Command {
-	var authToken string
+func NewCmd(cfg config.Config, userReposService userReposService, pullRequestService pullRequestService, teamsService teamsService) *cli.Command {
+	var authToken, team, teamRole string
+	var includeBots bool
+	var repositorySelector flag.RepositorySelector
 
 	flagBuilder := flag.New(cfg)
 
@@ -32,8 +40,20 @@ func NewCmd(cfg config.Config, userReposService userReposService, pullRequestSer
 		Usage:   "Display a widget based terminal which will include all the details required.",
 		Flags: flagBuilder.
 			AppendAuthFlag(&authToken).
+			AppendRepositorySelectorFlags(&repositorySelector).
+			AppendTeamFlag(&team).
+			AppendTeamRoleFlag(&teamRole).
+			AppendIncludeBotsFlag(&includeBots).
 			GetFlags(),
 		Action: func(c *cli.Context) error {
+			selector := repositorySelector.Get()
+
+			teamMembers, err := teamsService.GetFilterMembers(authToken, team, teamRole)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
+
 			app := tview.NewApplication()
 
 			selectedPrState := cfg.Settings.PullRequestState
@@ -71,7 +91,11 @@ func NewCmd(cfg config.Config, userReposService userReposService, pullRequestSer
 				SetBorders(true).
 				AddItem(headerForm, 0, 0, 1, 2, 20, 0, false)
 
-			userRepositories := getAllUserRepoNames(userReposService, authToken, cfg.Settings.PageSize)
+			userRepositories, err := userReposService.SelectRepositories(authToken, selector)
+			if err != nil {
+				fmt.Println(err)
+				return err
+			}
 
 			pullRequestsList := tview.NewList().SetSelectedBackgroundColor(tcell.ColorWhiteSmoke)
 			userReposList := tview.NewList().SetSelectedBackgroundColor(tcell.ColorLightYellow)
@@ -89,6 +113,8 @@ func NewCmd(cfg config.Config, userReposService userReposService, pullRequestSer
 					details := strings.Split(userRepo.FullName, "/")
 
 					prList := getAllPullRequestsForRepo(pullRequestService, authToken, details[0], details[1], baseBranch, selectedPrState, cfg.Settings.PageSize)
+					prList = teamsService.FilterPullRequests(prList, teamMembers, teamRole)
+					prList, _ = pullRequestService.FilterAutomatedPullRequests(prList, includeBots)
 					if len(prList) == 0 {
 						pullRequestsList.AddItem("No pull requests found!", "", '-', nil)
 					} else {
@@ -124,30 +150,6 @@ func NewCmd(cfg config.Config, userReposService userReposService, pullRequestSer
 	return &widgetCmd
 }
 
-// getAllUserRepoNames retrieve all the repos that the user has access to and returns a list of their names.
-func getAllUserRepoNames(userReposService userReposService, authToken string, pageSize int) []domain.Repository {
-	var userRepositories []domain.Repository
-	currentPage := 1
-
-	for {
-		userRepos, err := userReposService.GetUserRepos(authToken, pageSize, currentPage)
-		if err != nil {
-			fmt.Println(err)
-			return []domain.Repository{}
-		}
-
-		userRepositories = append(userRepositories, userRepos.Repositories...)
-
-		if len(userRepos.Repositories) < pageSize {
-			break
-		}
-
-		currentPage++
-	}
-
-	return userRepositories
-}
-
 // getAllPullRequestsForRepo retrieves all the repositories for a respective service.
 func getAllPullRequestsForRepo(pullRequestService pullRequestService, authToken, repoOwner, repository, baseBranch, prState string, pageSize int) []domain.PullRequest {
 	var pullRequestsOfRepository []domain.PullRequest
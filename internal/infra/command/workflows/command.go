@@ -50,7 +50,7 @@ func NewCmd(cfg config.Config, service service, tablePrinter tablePrinter, utili
         Usage:   "Retrieves and prints the workflow executions of a repository.",
         Flags: flagBuilder.
             AppendAuthFlag(&authToken).
-            AppendOwnerFlag(&repoOwner).
+            AppendOwnerFlag(&repoOwner, true).
             AppendRepositoryFlag(&repository).
             GetFlags(),
         Subcommands: []*cli.Command{
@@ -2,8 +2,10 @@ package flag
 
 import (
     "fmt"
+    "strings"
 
     "github.com/eujoy/gitpr/internal/config"
+    "github.com/eujoy/gitpr/internal/domain"
     "github.com/urfave/cli/v2"
 )
 
@@ -21,6 +23,30 @@ func New(cfg config.Config) *builder {
     }
 }
 
+// RepositorySelector keeps the values of the flags that define the criteria to select the repositories to use.
+type RepositorySelector struct {
+    Organization    string
+    Team            string
+    Topics          cli.StringSlice
+    Languages       cli.StringSlice
+    NameRegex       string
+    ExcludeArchived bool
+    ExcludeForks    bool
+}
+
+// Get returns the repository selector as defined by the values of the flags.
+func (s *RepositorySelector) Get() domain.RepositorySelector {
+    return domain.RepositorySelector{
+        Organization:    s.Organization,
+        Team:            s.Team,
+        Topics:          s.Topics.Value(),
+        Languages:       s.Languages.Value(),
+        NameRegex:       s.NameRegex,
+        ExcludeArchived: s.ExcludeArchived,
+        ExcludeForks:    s.ExcludeForks,
+    }
+}
+
 // GetFlags returns all the flags that have been assigned in the list.
 func (b *builder) GetFlags() []cli.Flag {
     return b.flagDefinition
@@ -44,7 +70,7 @@ func (b *builder) AppendAuthFlag(destination *string) *builder {
 }
 
 // AppendOwnerFlag appends the 'owner' flag in the flag list.
-func (b *builder) AppendOwnerFlag(destination *string) *builder {
+func (b *builder) AppendOwnerFlag(destination *string, required bool) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
@@ -53,7 +79,7 @@ func (b *builder) AppendOwnerFlag(destination *string) *builder {
             Usage:       "Owner of the repository to use.",
             Value:       "",
             Destination: destination,
-            Required:    true,
+            Required:    required,
         },
     )
 
@@ -77,6 +103,70 @@ func (b *builder) AppendRepositoryFlag(destination *string) *builder {
     return b
 }
 
+// AppendOrganizationFlag appends the 'org' flag in the flag list.
+func (b *builder) AppendOrganizationFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "org",
+            Usage:       "Organization to restrict the repositories to.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRepositoryTeamFlag appends the 'repo_team' flag in the flag list.
+func (b *builder) AppendRepositoryTeamFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "repo_team",
+            Usage:       "Name of the team (as defined in the configuration) to use the repositories of.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRepositoryNamesFlag appends the 'repository' flag, which can be provided multiple times, in the flag list.
+func (b *builder) AppendRepositoryNamesFlag(destination *cli.StringSlice) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "repository",
+            Aliases:     []string{"r"},
+            Usage:       "Names of the repositories to use. The ones of other owners can be provided as 'owner/repository'.",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRepositoriesFlag appends the 'repositories' flag in the flag list.
+func (b *builder) AppendRepositoriesFlag(destination *cli.StringSlice, required bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "repositories",
+            Aliases:     []string{"repos"},
+            Usage:       "Full names of the repositories to use. [Expected format: 'owner/repository']",
+            Destination: destination,
+            Required:    required,
+        },
+    )
+
+    return b
+}
+
 // AppendBaseFlag appends the 'base' flag in the flag list.
 func (b *builder) AppendBaseFlag(destination *string) *builder {
     b.flagDefinition = append(
@@ -94,6 +184,24 @@ func (b *builder) AppendBaseFlag(destination *string) *builder {
     return b
 }
 
+// AppendAnyBaseFlag appends the 'base' flag in the flag list, which is empty by default so that the pull requests of any
+// base branch are taken into account.
+func (b *builder) AppendAnyBaseFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "base",
+            Aliases:     []string{"b"},
+            Usage:       "Base branch to check pull requests against. By default, the pull requests of any base branch are checked.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
 // AppendStateFlag appends the 'state' flag in the flag list.
 func (b *builder) AppendStateFlag(destination *string) *builder {
     b.flagDefinition = append(
@@ -111,6 +219,162 @@ func (b *builder) AppendStateFlag(destination *string) *builder {
     return b
 }
 
+// AppendCreatorsFlag appends the 'creators' flag in the flag list.
+func (b *builder) AppendCreatorsFlag(destination *cli.StringSlice) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "creators",
+            Aliases:     []string{"authors"},
+            Usage:       "Usernames of the creators of the pull requests to keep.",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendReviewersFlag appends the 'reviewers' flag in the flag list.
+func (b *builder) AppendReviewersFlag(destination *cli.StringSlice) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "reviewers",
+            Usage:       "Usernames of the requested reviewers or reviewers of the pull requests to keep.",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendLabelsFlag appends the 'labels' flag in the flag list.
+func (b *builder) AppendLabelsFlag(destination *cli.StringSlice) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "labels",
+            Usage:       "Labels of the pull requests to keep. A pull request is kept when it has any of them.",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRepositorySelectorFlags appends the flags that select the repositories to use in the flag list.
+func (b *builder) AppendRepositorySelectorFlags(selector *RepositorySelector) *builder {
+    return b.
+        AppendOrganizationFlag(&selector.Organization).
+        AppendRepositoryTeamFlag(&selector.Team).
+        AppendTopicsFlag(&selector.Topics).
+        AppendLanguagesFlag(&selector.Languages).
+        AppendNameRegexFlag(&selector.NameRegex).
+        AppendExcludeArchivedFlag(&selector.ExcludeArchived).
+        AppendExcludeForksFlag(&selector.ExcludeForks)
+}
+
+// AppendTopicsFlag appends the 'topic' flag in the flag list.
+func (b *builder) AppendTopicsFlag(destination *cli.StringSlice) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "topic",
+            Usage:       "Topics of the repositories to use. A repository is used when it has any of them.",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendLanguagesFlag appends the 'language' flag in the flag list.
+func (b *builder) AppendLanguagesFlag(destination *cli.StringSlice) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringSliceFlag{
+            Name:        "language",
+            Usage:       "Primary languages of the repositories to use. A repository is used when it is written in any of them.",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendNameRegexFlag appends the 'name_regex' flag in the flag list.
+func (b *builder) AppendNameRegexFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "name_regex",
+            Aliases:     []string{"name-regex"},
+            Usage:       "Regular expression that the names of the repositories to use need to match.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendExcludeArchivedFlag appends the 'exclude_archived' flag in the flag list.
+func (b *builder) AppendExcludeArchivedFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "exclude_archived",
+            Aliases:     []string{"exclude-archived"},
+            Usage:       "Exclude the archived repositories.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendExcludeForksFlag appends the 'exclude_forks' flag in the flag list.
+func (b *builder) AppendExcludeForksFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "exclude_forks",
+            Aliases:     []string{"exclude-forks"},
+            Usage:       "Exclude the repositories that are forks.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendReviewStatusFlag appends the 'review_status' flag in the flag list.
+func (b *builder) AppendReviewStatusFlag(destination *string, statuses []string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "review_status",
+            Aliases:     []string{"review-status"},
+            Usage:       fmt.Sprintf("Review status of the pull requests to keep. [%v]", strings.Join(statuses, "|")),
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
 // AppendStartDateFlag appends the 'start_date' flag in the flag list.
 func (b *builder) AppendStartDateFlag(destination *string, required bool) *builder {
     b.flagDefinition = append(
@@ -146,7 +410,7 @@ func (b *builder) AppendEndDateFlag(destination *string, required bool) *builder
 }
 
 // AppendStartTagFlag appends the 'start_tag' flag in the flag list.
-func (b *builder) AppendStartTagFlag(destination *string) *builder {
+func (b *builder) AppendStartTagFlag(destination *string, required bool) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
@@ -154,7 +418,7 @@ func (b *builder) AppendStartTagFlag(destination *string) *builder {
             Usage:       "The starting tag/commit to compare against.",
             Value:       "",
             Destination: destination,
-            Required:    true,
+            Required:    required,
         },
     )
 
@@ -162,7 +426,7 @@ func (b *builder) AppendStartTagFlag(destination *string) *builder {
 }
 
 // AppendEndTagFlag appends the 'start_tag' flag in the flag list.
-func (b *builder) AppendEndTagFlag(destination *string) *builder {
+func (b *builder) AppendEndTagFlag(destination *string, required bool) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
@@ -170,7 +434,7 @@ func (b *builder) AppendEndTagFlag(destination *string) *builder {
             Usage:       "The ending/latest tag/commit to compare against.",
             Value:       "HEAD",
             Destination: destination,
-            Required:    true,
+            Required:    required,
         },
     )
 
@@ -218,24 +482,8 @@ func (b *builder) AppendReleaseTagFlag(destination *string) *builder {
         &cli.StringFlag{
             Name:        "release_tag",
             Aliases:     []string{"v"},
-            Usage:       "Release tag to be used (and checked against if exists).",
-            Value:       "HEAD",
-            Destination: destination,
-            Required:    true,
-        },
-    )
-
-    return b
-}
-
-// AppendCheckPatternFlag appends the 'check_pattern' flag in the flag list.
-func (b *builder) AppendCheckPatternFlag(destination *cli.StringSlice) *builder {
-    b.flagDefinition = append(
-        b.flagDefinition,
-        &cli.StringSliceFlag{
-            Name:        "check_pattern",
-            Aliases:     []string{"p"},
-            Usage:       "Define the pattern to check the files modified against.",
+            Usage:       "Release tag to be used (and checked against if exists). In case it is not provided, the next version is suggested based on the latest tag and the commits since then.",
+            Value:       "",
             Destination: destination,
             Required:    false,
         },
@@ -244,14 +492,14 @@ func (b *builder) AppendCheckPatternFlag(destination *cli.StringSlice) *builder
     return b
 }
 
-// AppendSpreadsheetID appends the 'spreadsheet_id' flag in the flag list.
-func (b *builder) AppendSpreadsheetID(destination *string) *builder {
+// AppendTagFlag appends the 'tag' flag in the flag list.
+func (b *builder) AppendTagFlag(destination *string) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
-            Name:        "spreadsheet_id",
-            Aliases:     []string{"sid"},
-            Usage:       "Define the id of the spreadsheet to publish the data to.",
+            Name:        "tag",
+            Usage:       "Tag of the release to use.",
+            Value:       "",
             Destination: destination,
             Required:    true,
         },
@@ -260,14 +508,13 @@ func (b *builder) AppendSpreadsheetID(destination *string) *builder {
     return b
 }
 
-// AppendPullRequestSheetName appends the 'sheet_name' flag in the flag list.
-func (b *builder) AppendPullRequestSheetName(destination *string) *builder {
+// AppendNameFlag appends the 'name' flag in the flag list.
+func (b *builder) AppendNameFlag(destination *string) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
-            Name:        "pr_sheet_name",
-            Aliases:     []string{"pr_sheet"},
-            Usage:       "Define the name of the sheet to store the report data for pull requests to. By default, it will be 'OverallData-{repositoryName}'",
+            Name:        "name",
+            Usage:       "New name of the release.",
             Value:       "",
             Destination: destination,
             Required:    false,
@@ -277,14 +524,13 @@ func (b *builder) AppendPullRequestSheetName(destination *string) *builder {
     return b
 }
 
-// AppendReleaseSheetName appends the 'sheet_name' flag in the flag list.
-func (b *builder) AppendReleaseSheetName(destination *string) *builder {
+// AppendBodyFlag appends the 'body' flag in the flag list.
+func (b *builder) AppendBodyFlag(destination *string) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
-            Name:        "rel_sheet_name",
-            Aliases:     []string{"rel_sheet"},
-            Usage:       "Define the name of the sheet to store the report data for releases to. By default, it will be 'Release-{repositoryName}'",
+            Name:        "body",
+            Usage:       "New description of the release.",
             Value:       "",
             Destination: destination,
             Required:    false,
@@ -294,31 +540,31 @@ func (b *builder) AppendReleaseSheetName(destination *string) *builder {
     return b
 }
 
-// AppendSprintSummary appends the 'sprint_summary' flag in the flag list.
-func (b *builder) AppendSprintSummary(destination *string) *builder {
+// AppendBodyFileFlag appends the 'body_file' flag in the flag list.
+func (b *builder) AppendBodyFileFlag(destination *string) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.StringFlag{
-            Name:        "sprint_summary",
-            Aliases:     []string{"sprints"},
-            Usage:       "Define the summary level details of the sprints to take into account. Expecting json formatted array of sprint summary details. Example : [{\"number\":1,\"name\":\"My Sprint 1\",\"start_date\":\"2020-08-03\",\"end_date\":\"2020-08-17\"},{\"number\":2,\"name\":\"My Sprint 2\",\"start_date\":\"2020-09-08\",\"end_date\":\"2020-09-22\"}]",
+            Name:        "body_file",
+            Aliases:     []string{"body-file"},
+            Usage:       "Path of the file to read the new description of the release from.",
+            Value:       "",
             Destination: destination,
-            Required:    true,
+            Required:    false,
         },
     )
 
     return b
 }
 
-// AppendPageSizeFlag appends the 'page_size' flag in the flag list.
-func (b *builder) AppendPageSizeFlag(destination *int, defaultSize int) *builder {
+// AppendCheckPatternFlag appends the 'check_pattern' flag in the flag list.
+func (b *builder) AppendCheckPatternFlag(destination *cli.StringSlice) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
-        &cli.IntFlag{
-            Name:        "page_size",
-            Aliases:     []string{"s"},
-            Usage:       "Size of each page to load.",
-            Value:       defaultSize,
+        &cli.StringSliceFlag{
+            Name:        "check_pattern",
+            Aliases:     []string{"p"},
+            Usage:       "Define the pattern to check the files modified against. Plain patterns match the paths that contain them, patterns with '*', '?' or '**' are globs and patterns prefixed with 're:' are regular expressions.",
             Destination: destination,
             Required:    false,
         },
@@ -327,15 +573,14 @@ func (b *builder) AppendPageSizeFlag(destination *int, defaultSize int) *builder
     return b
 }
 
-// AppendVersionPatternWithServiceInitialsFlag appends the 'version_pattern_with_service_initials' flag in the flag list.
-func (b *builder) AppendVersionPatternWithServiceInitialsFlag(destination *int, versionPatternWithServiceInitials string) *builder {
+// AppendExcludePatternFlag appends the 'exclude_pattern' flag in the flag list.
+func (b *builder) AppendExcludePatternFlag(destination *cli.StringSlice) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
-        &cli.IntFlag{
-            Name:        "version_pattern_with_service_initials",
-            Aliases:     []string{"vpwsi"},
-            Usage:       fmt.Sprintf("Enables the release version pattern that uses the provided number of letters for service initials to be used. [pattern format: %v]", versionPatternWithServiceInitials),
-            Value:       0,
+        &cli.StringSliceFlag{
+            Name:        "exclude_pattern",
+            Aliases:     []string{"exclude-pattern"},
+            Usage:       "Define the pattern of the files modified to ignore, in the same format as the check pattern.",
             Destination: destination,
             Required:    false,
         },
@@ -344,15 +589,13 @@ func (b *builder) AppendVersionPatternWithServiceInitialsFlag(destination *int,
     return b
 }
 
-// AppendPrintJsonFlag appends the 'print_json' flag in the flag list.
-func (b *builder) AppendPrintJsonFlag(destination *bool) *builder {
+// AppendServicesFlag appends the 'services' flag in the flag list.
+func (b *builder) AppendServicesFlag(destination *bool) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
         &cli.BoolFlag{
-            Name:        "print_json",
-            Aliases:     []string{"json"},
-            Usage:       "Define whether the output needs to be printed in json format.",
-            Value:       false,
+            Name:        "services",
+            Usage:       "Create one release for each service of the configuration that has changes since its latest release tag.",
             Destination: destination,
             HasBeenSet:  false,
             Required:    false,
@@ -362,24 +605,473 @@ func (b *builder) AppendPrintJsonFlag(destination *bool) *builder {
     return b
 }
 
-// AppendDraftReleaseFlag appends the 'draft_release' flag in the flag list.
-func (b *builder) AppendDraftReleaseFlag(destination *bool) *builder {
+// AppendAssetFlag appends the 'asset' flag in the flag list.
+func (b *builder) AppendAssetFlag(destination *cli.StringSlice) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
-        &cli.BoolFlag{
-            Name:        "draft_release",
-            Aliases:     []string{"d"},
-            Usage:       "Defines if the release will be a draft or published. (default: false)",
+        &cli.StringSliceFlag{
+            Name:        "asset",
+            Usage:       "Glob pattern of the files to upload as assets of the release. Multiple patterns can be provided.",
             Destination: destination,
-            HasBeenSet:  false,
-            Required:    false,
+            Required:    true,
         },
     )
 
     return b
 }
 
-// AppendForceCreateFlag appends the 'force_create' flag in the flag list.
+// AppendSpreadsheetID appends the 'spreadsheet_id' flag in the flag list.
+func (b *builder) AppendSpreadsheetID(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "spreadsheet_id",
+            Aliases:     []string{"sid"},
+            Usage:       "Define the id of the spreadsheet to publish the data to.",
+            Destination: destination,
+            Required:    true,
+        },
+    )
+
+    return b
+}
+
+// AppendPullRequestSheetName appends the 'sheet_name' flag in the flag list.
+func (b *builder) AppendPullRequestSheetName(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "pr_sheet_name",
+            Aliases:     []string{"pr_sheet"},
+            Usage:       "Define the name of the sheet to store the report data for pull requests to. By default, it will be 'OverallData-{repositoryName}'",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendReleaseSheetName appends the 'sheet_name' flag in the flag list.
+func (b *builder) AppendReleaseSheetName(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "rel_sheet_name",
+            Aliases:     []string{"rel_sheet"},
+            Usage:       "Define the name of the sheet to store the report data for releases to. By default, it will be 'Release-{repositoryName}'",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendCategorySheetName appends the 'cat_sheet_name' flag in the flag list.
+func (b *builder) AppendCategorySheetName(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "cat_sheet_name",
+            Aliases:     []string{"cat_sheet"},
+            Usage:       "Define the name of the sheet to store the report data for the categories of pull requests to. By default, it will be 'Categories-{repositoryName}'",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendSprintSummary appends the 'sprint_summary' flag in the flag list.
+func (b *builder) AppendSprintSummary(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "sprint_summary",
+            Aliases:     []string{"sprints"},
+            Usage:       "Define the summary level details of the sprints to take into account. Expecting json formatted array of sprint summary details. Example : [{\"number\":1,\"name\":\"My Sprint 1\",\"start_date\":\"2020-08-03\",\"end_date\":\"2020-08-17\"},{\"number\":2,\"name\":\"My Sprint 2\",\"start_date\":\"2020-09-08\",\"end_date\":\"2020-09-22\"}]",
+            Destination: destination,
+            Required:    true,
+        },
+    )
+
+    return b
+}
+
+// AppendHeadBranchFlag appends the 'branch' flag in the flag list.
+func (b *builder) AppendHeadBranchFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "branch",
+            Aliases:     []string{"head"},
+            Usage:       "Branch that the open pull request to use has been created from.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendTeamFlag appends the 'team' flag in the flag list.
+func (b *builder) AppendTeamFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "team",
+            Usage:       "Name of the team (as defined in the configuration) to restrict the pull requests to.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendTeamRoleFlag appends the 'team_role' flag in the flag list.
+func (b *builder) AppendTeamRoleFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "team_role",
+            Aliases:     []string{"team-role"},
+            Usage:       "Define whether the team members shall be the authors or the reviewers of the pull requests. [author|reviewer]",
+            Value:       domain.TeamRoleAuthor,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendPeriodFlag appends the 'period' flag in the flag list.
+func (b *builder) AppendPeriodFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "period",
+            Usage:       "Period to group the results with. [day|week|month]",
+            Value:       "week",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendFormatFlag appends the 'format' flag in the flag list.
+func (b *builder) AppendFormatFlag(destination *string, formats []string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "format",
+            Usage:       fmt.Sprintf("Format of the output. [%v]", strings.Join(formats, "|")),
+            Value:       formats[0],
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendCompareToFlag appends the 'compare_to' flag in the flag list.
+func (b *builder) AppendCompareToFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "compare_to",
+            Aliases:     []string{"compare-to"},
+            Usage:       "Period to compare the metrics with. Use 'previous' for the period of the same length right before the start date or provide a custom period. [previous|yyyy-mm-dd:yyyy-mm-dd]",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendPageSizeFlag appends the 'page_size' flag in the flag list.
+func (b *builder) AppendPageSizeFlag(destination *int, defaultSize int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "page_size",
+            Aliases:     []string{"s"},
+            Usage:       "Size of each page to load.",
+            Value:       defaultSize,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendDepthFlag appends the 'depth' flag in the flag list.
+func (b *builder) AppendDepthFlag(destination *int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "depth",
+            Usage:       "Number of directory levels to roll the changed files up to. Use 0 to keep the full directory of each file.",
+            Value:       b.cfg.Hotspots.Depth,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendTopFlag appends the 'top' flag in the flag list.
+func (b *builder) AppendTopFlag(destination *int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "top",
+            Usage:       "Number of entries to keep for each one of the rankings. Use 0 to keep all of them.",
+            Value:       b.cfg.Hotspots.Top,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRequestFlag appends the 'request' flag in the flag list.
+func (b *builder) AppendRequestFlag(destination *int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "request",
+            Usage:       "Number of the top ranked candidates to request the review of the pull request from. Use 0 to only list the candidates.",
+            Value:       0,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendPullRequestNumberFlag appends the 'number' flag in the flag list.
+func (b *builder) AppendPullRequestNumberFlag(destination *int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "number",
+            Aliases:     []string{"pr"},
+            Usage:       "Number of the pull request to use.",
+            Value:       0,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendMonthsFlag appends the 'months' flag in the flag list.
+func (b *builder) AppendMonthsFlag(destination *int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "months",
+            Usage:       "Number of months of commit history to check, in case no tag range is provided.",
+            Value:       b.cfg.Knowledge.Months,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendReviewerMonthsFlag appends the 'months' flag in the flag list.
+func (b *builder) AppendReviewerMonthsFlag(destination *int) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "months",
+            Usage:       "Number of months of commit history to check for the authors of the modified files.",
+            Value:       b.cfg.Reviewers.Months,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendConcentrationThresholdFlag appends the 'concentration_threshold' flag in the flag list.
+func (b *builder) AppendConcentrationThresholdFlag(destination *float64) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.Float64Flag{
+            Name:        "concentration_threshold",
+            Aliases:     []string{"threshold"},
+            Usage:       "Percentage of the changes of a directory made by a single author above which the directory is flagged.",
+            Value:       b.cfg.Knowledge.ConcentrationThreshold,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendVersionPatternWithServiceInitialsFlag appends the 'version_pattern_with_service_initials' flag in the flag list.
+func (b *builder) AppendVersionPatternWithServiceInitialsFlag(destination *int, versionPatternWithServiceInitials string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.IntFlag{
+            Name:        "version_pattern_with_service_initials",
+            Aliases:     []string{"vpwsi"},
+            Usage:       fmt.Sprintf("Enables the release version pattern that uses the provided number of letters for service initials to be used. [pattern format: %v]", versionPatternWithServiceInitials),
+            Value:       0,
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendPrintJsonFlag appends the 'print_json' flag in the flag list.
+func (b *builder) AppendPrintJsonFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "print_json",
+            Aliases:     []string{"json"},
+            Usage:       "Define whether the output needs to be printed in json format.",
+            Value:       false,
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendPrintCsvFlag appends the 'print_csv' flag in the flag list.
+func (b *builder) AppendPrintCsvFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "print_csv",
+            Aliases:     []string{"csv"},
+            Usage:       "Define whether the output needs to be printed in csv format.",
+            Value:       false,
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendFailOnStaleFlag appends the 'fail_on_stale' flag in the flag list.
+func (b *builder) AppendFailOnStaleFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "fail_on_stale",
+            Aliases:     []string{"ci"},
+            Usage:       fmt.Sprintf("Exit with code %d in case stale pull requests are found.", b.cfg.Aging.AlertExitCode),
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendExcludeGeneratedFlag appends the 'exclude_generated' flag in the flag list.
+func (b *builder) AppendExcludeGeneratedFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "exclude_generated",
+            Aliases:     []string{"exclude-generated"},
+            Usage:       "Exclude the files matching the configured generated and vendored paths from the size of the pull requests and the hotspots.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendByOwnerFlag appends the 'by_owner' flag in the flag list.
+func (b *builder) AppendByOwnerFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "by_owner",
+            Aliases:     []string{"by-owner"},
+            Usage:       "Group the report per code owner, as defined in the CODEOWNERS file of the repository.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendIncludeBotsFlag appends the 'include_bots' flag in the flag list.
+func (b *builder) AppendIncludeBotsFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "include_bots",
+            Aliases:     []string{"include-bots"},
+            Usage:       "Include the pull requests created by bots and automation accounts in the results.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendDraftReleaseFlag appends the 'draft_release' flag in the flag list.
+func (b *builder) AppendDraftReleaseFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "draft_release",
+            Aliases:     []string{"d"},
+            Usage:       "Defines if the release will be a draft or published. (default: false)",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendForceCreateFlag appends the 'force_create' flag in the flag list.
 func (b *builder) AppendForceCreateFlag(destination *bool) *builder {
     b.flagDefinition = append(
         b.flagDefinition,
@@ -396,6 +1088,220 @@ func (b *builder) AppendForceCreateFlag(destination *bool) *builder {
     return b
 }
 
+// AppendPreReleaseFlag appends the 'prerelease' flag in the flag list.
+func (b *builder) AppendPreReleaseFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "prerelease",
+            Usage:       "Mark the release as a pre-release, or not through '--prerelease=false'. The release is left as it is when the flag is not provided.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendForceFlag appends the 'force' flag in the flag list.
+func (b *builder) AppendForceFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "force",
+            Aliases:     []string{"f"},
+            Usage:       "Applies the changes without asking for confirmation.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendReplaceFlag appends the 'replace' flag in the flag list.
+func (b *builder) AppendReplaceFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "replace",
+            Usage:       "Replace the assets of the release that have the same name, instead of skipping them.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendSkipChecksumsFlag appends the 'skip_checksums' flag in the flag list.
+func (b *builder) AppendSkipChecksumsFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "skip_checksums",
+            Aliases:     []string{"skip-checksums"},
+            Usage:       "Skip the generation and the upload of the SHA256SUMS file with the checksums of the assets.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendChangelogFlag appends the 'changelog' flag in the flag list.
+func (b *builder) AppendChangelogFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "changelog",
+            Usage:       "Group the commits in the sections of a changelog based on their conventional commit messages.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendReleaseNotesFlag appends the 'release_notes' flag in the flag list.
+func (b *builder) AppendReleaseNotesFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "release_notes",
+            Aliases:     []string{"release-notes"},
+            Usage:       "Build the release notes out of the merged pull requests that the commits belong to, instead of the commit messages.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendTemplateFlag appends the 'template' flag in the flag list.
+func (b *builder) AppendTemplateFlag(destination *string, templates []string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "template",
+            Usage:       fmt.Sprintf("Name of the template to print the commits with. By default, the template of the command is used. [%v]", strings.Join(templates, "|")),
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendTemplateFileFlag appends the 'template_file' flag in the flag list.
+func (b *builder) AppendTemplateFileFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "template_file",
+            Aliases:     []string{"template-file"},
+            Usage:       "Path of the Go template file to render the release notes with, instead of the default template.",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendChangelogFileFlag appends the 'file' flag in the flag list.
+func (b *builder) AppendChangelogFileFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "file",
+            Usage:       "Path of the changelog file to update, either locally or in the repository.",
+            Value:       "CHANGELOG.md",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendPullRequestBranchFlag appends the 'pr_branch' flag in the flag list.
+func (b *builder) AppendPullRequestBranchFlag(destination *string) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.StringFlag{
+            Name:        "pr_branch",
+            Aliases:     []string{"pr-branch"},
+            Usage:       "Branch to commit the changes to and open a pull request from. (default: changelog/<release tag>-<timestamp>)",
+            Value:       "",
+            Destination: destination,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRemoteFlag appends the 'remote' flag in the flag list.
+func (b *builder) AppendRemoteFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "remote",
+            Usage:       "Update the file of the repository on a new branch and open a pull request, instead of updating a local file.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendAllTagsFlag appends the 'all' flag in the flag list.
+func (b *builder) AppendAllTagsFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "all",
+            Usage:       "Regenerate the sections of all the tags of the repository that are semantic versions, instead of a single tag range.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendDryRunFlag appends the 'dry_run' flag in the flag list.
+func (b *builder) AppendDryRunFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "dry_run",
+            Aliases:     []string{"dry-run"},
+            Usage:       "Print the outcome instead of applying any change.",
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
 // AppendDefaultVersionPatternFlag appends the 'default_version_pattern' flag in the flag list.
 func (b *builder) AppendDefaultVersionPatternFlag(destination *bool, defaultVersionPattern string) *builder {
     b.flagDefinition = append(
@@ -412,3 +1318,38 @@ func (b *builder) AppendDefaultVersionPatternFlag(destination *bool, defaultVers
 
     return b
 }
+
+// AppendFailOnUncheckedFlag appends the 'fail_on_unchecked' flag in the flag list.
+func (b *builder) AppendFailOnUncheckedFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "fail_on_unchecked",
+            Aliases:     []string{"fail-on-unchecked"},
+            Usage:       fmt.Sprintf("Exit with code %d in case the checklist of the release has unchecked items.", b.cfg.ReleaseChecklist.AlertExitCode),
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
+
+// AppendRequireCheckedFlag appends the 'require_checked' flag in the flag list.
+func (b *builder) AppendRequireCheckedFlag(destination *bool) *builder {
+    b.flagDefinition = append(
+        b.flagDefinition,
+        &cli.BoolFlag{
+            Name:        "require_checked",
+            Aliases:     []string{"require-checked"},
+            Usage:       "Require all the items of the checklist of the release to be checked before publishing it.",
+            Value:       b.cfg.ReleaseChecklist.RequireChecked,
+            Destination: destination,
+            HasBeenSet:  false,
+            Required:    false,
+        },
+    )
+
+    return b
+}
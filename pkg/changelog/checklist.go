@@ -0,0 +1,59 @@
+package changelog
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+var (
+	checklistItemRegex   = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	checklistAuthorRegex = regexp.MustCompile(`^\(@([\w-]+(?:\[bot\])?)\)\s*\|\s*`)
+)
+
+// ParseChecklist parses the task list items of the description of a release, like the ones of the release template,
+// where each commit is listed as '- [ ] (@author) | message'. The author of an item is only taken from the leading
+// '(@user) |' of it, which is removed from the text of the item, so that any other mention of a user is kept as text.
+func ParseChecklist(body string) domain.ReleaseChecklist {
+	checklist := domain.ReleaseChecklist{
+		Items:            []domain.ReleaseChecklistItem{},
+		UncheckedAuthors: []string{},
+	}
+
+	uncheckedAuthors := make(map[string]bool)
+	for idx, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		match := checklistItemRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		item := domain.ReleaseChecklistItem{
+			Line:    idx + 1,
+			Checked: match[1] != " ",
+			Text:    strings.TrimSpace(match[2]),
+		}
+
+		if author := checklistAuthorRegex.FindStringSubmatch(match[2]); author != nil {
+			item.Author = author[1]
+			item.Text = strings.TrimSpace(strings.TrimPrefix(match[2], author[0]))
+		}
+
+		if item.Checked {
+			checklist.Checked++
+		} else {
+			checklist.Unchecked++
+			if item.Author != "" && !uncheckedAuthors[item.Author] {
+				uncheckedAuthors[item.Author] = true
+				checklist.UncheckedAuthors = append(checklist.UncheckedAuthors, item.Author)
+			}
+		}
+
+		checklist.Items = append(checklist.Items, item)
+	}
+
+	sort.Strings(checklist.UncheckedAuthors)
+
+	return checklist
+}
@@ -0,0 +1,56 @@
+package changelog_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+func TestParseChecklist(t *testing.T) {
+	testCases := map[string]struct {
+		body     string
+		expected domain.ReleaseChecklist
+	}{
+		"Checklist of the release template": {
+			body: "Commits included from last release :\r\n- [x] (@alice) | fix: handle nil (#5)\r\n\r\nDetails.\r\n- [ ] (@bob) | feat: add tags\r\n- [ ] (@alice) | chore: tidy\r\n- [X] (@dependabot[bot]) | Bump yaml",
+			expected: domain.ReleaseChecklist{
+				Items: []domain.ReleaseChecklistItem{
+					{Line: 2, Checked: true, Author: "alice", Text: "fix: handle nil (#5)"},
+					{Line: 5, Checked: false, Author: "bob", Text: "feat: add tags"},
+					{Line: 6, Checked: false, Author: "alice", Text: "chore: tidy"},
+					{Line: 7, Checked: true, Author: "dependabot[bot]", Text: "Bump yaml"},
+				},
+				Checked:          2,
+				Unchecked:        2,
+				UncheckedAuthors: []string{"alice", "bob"},
+			},
+		},
+		"Items without an author and plain list items": {
+			body: "## QA\n\n* [ ] Smoke tests passed\n  - [x] Check the migration of (@carol)\n- Not a task",
+			expected: domain.ReleaseChecklist{
+				Items: []domain.ReleaseChecklistItem{
+					{Line: 3, Checked: false, Author: "", Text: "Smoke tests passed"},
+					{Line: 4, Checked: true, Author: "", Text: "Check the migration of (@carol)"},
+				},
+				Checked:          1,
+				Unchecked:        1,
+				UncheckedAuthors: []string{},
+			},
+		},
+		"Body without a checklist": {
+			body:     "Release version : v1.0.0",
+			expected: domain.ReleaseChecklist{Items: []domain.ReleaseChecklistItem{}, UncheckedAuthors: []string{}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := changelog.ParseChecklist(tc.body)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%+v' as checklist, but got '%+v'", tc.expected, actual)
+			}
+		})
+	}
+}
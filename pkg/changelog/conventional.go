@@ -0,0 +1,174 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const (
+	// FeatureType is the conventional commit type of the commits that introduce a new feature.
+	FeatureType = "feat"
+	// FixType is the conventional commit type of the commits that fix a bug.
+	FixType = "fix"
+
+	breakingChangeFooter = "BREAKING CHANGE"
+	shortShaLength       = 7
+)
+
+var (
+	headerRegex            = regexp.MustCompile(`^(\w+)(?:\(([^()]*)\))?(!)?: (.+)$`)
+	footerRegex            = regexp.MustCompile(`^(BREAKING[ -]CHANGE|[\w-]+)(?:: | #)(.*)$`)
+	pullRequestSuffixRegex = regexp.MustCompile(`\s*\(#(\d+)\)$`)
+	mergePullRequestRegex  = regexp.MustCompile(`^Merge pull request #(\d+) from \S+$`)
+)
+
+// ParseCommitMessage parses a commit message based on the conventional commits specification. The messages that do
+// not follow the specification are returned without a type and with the first line of the message as description.
+// The pull request number is retrieved either from the '(#123)' suffix of the squashed commits or from the merge
+// commits of github, in which case the title of the pull request is parsed instead.
+func ParseCommitMessage(message string) domain.ConventionalCommit {
+	paragraphs := splitParagraphs(message)
+	if len(paragraphs) == 0 {
+		return domain.ConventionalCommit{Footers: map[string]string{}}
+	}
+
+	pullRequestNumber := 0
+	if match := mergePullRequestRegex.FindStringSubmatch(paragraphs[0]); match != nil && len(paragraphs) > 1 {
+		pullRequestNumber, _ = strconv.Atoi(match[1])
+		paragraphs = paragraphs[1:]
+	}
+
+	header := strings.TrimSpace(strings.SplitN(paragraphs[0], "\n", 2)[0])
+	if match := pullRequestSuffixRegex.FindStringSubmatch(header); match != nil {
+		pullRequestNumber, _ = strconv.Atoi(match[1])
+		header = strings.TrimSpace(strings.TrimSuffix(header, match[0]))
+	}
+
+	commit := domain.ConventionalCommit{
+		Description:       header,
+		Footers:           map[string]string{},
+		PullRequestNumber: pullRequestNumber,
+	}
+
+	match := headerRegex.FindStringSubmatch(header)
+	if match == nil {
+		return commit
+	}
+
+	commit.Type = strings.ToLower(match[1])
+	commit.Scope = match[2]
+	commit.Breaking = match[3] == "!"
+	commit.Description = strings.TrimSpace(match[4])
+
+	body := paragraphs[1:]
+	if len(body) > 0 && isFooterParagraph(body[len(body)-1]) {
+		commit.Footers = parseFooters(body[len(body)-1])
+		body = body[:len(body)-1]
+	}
+
+	commit.Body = strings.Join(body, "\n\n")
+
+	for token, value := range commit.Footers {
+		if strings.ReplaceAll(token, "-", " ") == breakingChangeFooter {
+			commit.Breaking = true
+			commit.BreakingDescription = value
+		}
+	}
+
+	if commit.Breaking && commit.BreakingDescription == "" {
+		commit.BreakingDescription = commit.Description
+	}
+
+	return commit
+}
+
+// BuildChangelog parses the messages of the commits and groups them in the sections of a changelog. The breaking
+// changes are listed in their own section as well as in the section of their type. The links to the pull requests are
+// based on the url of the commits.
+func BuildChangelog(commitList []domain.Commit) domain.Changelog {
+	changelog := domain.Changelog{
+		BreakingChanges: []domain.ConventionalCommit{},
+		Features:        []domain.ConventionalCommit{},
+		Fixes:           []domain.ConventionalCommit{},
+		Other:           []domain.ConventionalCommit{},
+	}
+
+	for _, c := range commitList {
+		commit := parseCommit(c)
+
+		if commit.Breaking {
+			changelog.BreakingChanges = append(changelog.BreakingChanges, commit)
+		}
+
+		switch commit.Type {
+		case FeatureType:
+			changelog.Features = append(changelog.Features, commit)
+		case FixType:
+			changelog.Fixes = append(changelog.Fixes, commit)
+		default:
+			changelog.Other = append(changelog.Other, commit)
+		}
+	}
+
+	return changelog
+}
+
+// parseCommit parses the message of the commit and sets the details of the commit, like its url and author.
+func parseCommit(c domain.Commit) domain.ConventionalCommit {
+	commit := ParseCommitMessage(c.Details.Message)
+	commit.Sha = c.Sha
+	commit.ShortSha = ShortSha(c.Sha)
+	commit.Url = c.HtmlUrl
+	commit.Author = c.Author.Username
+
+	if commit.PullRequestNumber > 0 {
+		if idx := strings.Index(c.HtmlUrl, "/commit/"); idx >= 0 {
+			commit.PullRequestUrl = fmt.Sprintf("%v/pull/%v", c.HtmlUrl[:idx], commit.PullRequestNumber)
+		}
+	}
+
+	return commit
+}
+
+// splitParagraphs splits the message to its paragraphs, skipping the empty ones.
+func splitParagraphs(message string) []string {
+	message = strings.ReplaceAll(message, "\r\n", "\n")
+
+	paragraphs := []string{}
+	for _, p := range strings.Split(message, "\n\n") {
+		p = strings.Trim(p, "\n")
+		if strings.TrimSpace(p) != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+
+	return paragraphs
+}
+
+// isFooterParagraph checks whether the first line of the paragraph is a footer.
+func isFooterParagraph(paragraph string) bool {
+	return footerRegex.MatchString(strings.SplitN(paragraph, "\n", 2)[0])
+}
+
+// parseFooters parses the footers of the paragraph. The lines that are not footers are considered to be the
+// continuation of the value of the previous footer.
+func parseFooters(paragraph string) map[string]string {
+	footers := map[string]string{}
+
+	lastToken := ""
+	for _, line := range strings.Split(paragraph, "\n") {
+		if match := footerRegex.FindStringSubmatch(line); match != nil {
+			lastToken = match[1]
+			footers[lastToken] = strings.TrimSpace(match[2])
+			continue
+		}
+
+		footers[lastToken] = strings.TrimSpace(fmt.Sprintf("%v\n%v", footers[lastToken], line))
+	}
+
+	return footers
+}
@@ -0,0 +1,122 @@
+package changelog_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+func TestParseCommitMessage(t *testing.T) {
+	testCases := map[string]struct {
+		message  string
+		expected domain.ConventionalCommit
+	}{
+		"Commit with type and description": {
+			message:  "feat: add the changelog",
+			expected: domain.ConventionalCommit{Type: "feat", Description: "add the changelog", Footers: map[string]string{}},
+		},
+		"Commit with scope and pull request suffix": {
+			message:  "fix(api): handle empty responses (#12)",
+			expected: domain.ConventionalCommit{Type: "fix", Scope: "api", Description: "handle empty responses", Footers: map[string]string{}, PullRequestNumber: 12},
+		},
+		"Commit with breaking marker": {
+			message:  "refactor(cli)!: rename the flags",
+			expected: domain.ConventionalCommit{Type: "refactor", Scope: "cli", Description: "rename the flags", Breaking: true, BreakingDescription: "rename the flags", Footers: map[string]string{}},
+		},
+		"Commit with body and footers": {
+			message: "feat: support teams\n\nThe teams are read from the configuration.\n\nReviewed-by: alice\nBREAKING CHANGE: the team flag is required\nfor the metrics\nRefs #3",
+			expected: domain.ConventionalCommit{
+				Type:                "feat",
+				Description:         "support teams",
+				Body:                "The teams are read from the configuration.",
+				Breaking:            true,
+				BreakingDescription: "the team flag is required\nfor the metrics",
+				Footers:             map[string]string{"Reviewed-by": "alice", "BREAKING CHANGE": "the team flag is required\nfor the metrics", "Refs": "3"},
+			},
+		},
+		"Merge commit of a pull request": {
+			message:  "Merge pull request #7 from eujoy/feature\n\nfeat(release): create draft releases",
+			expected: domain.ConventionalCommit{Type: "feat", Scope: "release", Description: "create draft releases", Footers: map[string]string{}, PullRequestNumber: 7},
+		},
+		"Commit that does not follow the specification": {
+			message:  "Update README.md\n\nfix: typo",
+			expected: domain.ConventionalCommit{Description: "Update README.md", Footers: map[string]string{}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := changelog.ParseCommitMessage(tc.message)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%+v' as conventional commit, but got '%+v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBuildChangelog(t *testing.T) {
+	commit := func(sha, message string) domain.Commit {
+		return domain.Commit{
+			Sha:     sha,
+			HtmlUrl: "https://github.com/eujoy/gitpr/commit/" + sha,
+			Details: domain.CommitDetails{Message: message},
+			Author:  domain.User{Username: "alice"},
+		}
+	}
+
+	commitList := []domain.Commit{
+		commit("1111111aaa", "feat!: drop bitbucket (#4)"),
+		commit("2222222bbb", "fix: retry the requests"),
+		commit("3333333ccc", "chore: bump dependencies"),
+		commit("4444444ddd", "Initial commit"),
+	}
+
+	actual := changelog.BuildChangelog(commitList)
+
+	expectedSections := map[string][]string{
+		"breaking changes": {"1111111aaa"},
+		"features":         {"1111111aaa"},
+		"fixes":            {"2222222bbb"},
+		"other":            {"3333333ccc", "4444444ddd"},
+	}
+
+	actualSections := map[string][]string{
+		"breaking changes": shas(actual.BreakingChanges),
+		"features":         shas(actual.Features),
+		"fixes":            shas(actual.Fixes),
+		"other":            shas(actual.Other),
+	}
+
+	if !reflect.DeepEqual(expectedSections, actualSections) {
+		t.Errorf("Expected to get '%v' as changelog sections, but got '%v'", expectedSections, actualSections)
+	}
+
+	expectedFeature := domain.ConventionalCommit{
+		Sha:                 "1111111aaa",
+		ShortSha:            "1111111",
+		Url:                 "https://github.com/eujoy/gitpr/commit/1111111aaa",
+		Author:              "alice",
+		Type:                "feat",
+		Description:         "drop bitbucket",
+		Breaking:            true,
+		BreakingDescription: "drop bitbucket",
+		Footers:             map[string]string{},
+		PullRequestNumber:   4,
+		PullRequestUrl:      "https://github.com/eujoy/gitpr/pull/4",
+	}
+
+	if !reflect.DeepEqual(expectedFeature, actual.Features[0]) {
+		t.Errorf("Expected to get '%+v' as feature, but got '%+v'", expectedFeature, actual.Features[0])
+	}
+}
+
+func shas(commits []domain.ConventionalCommit) []string {
+	list := []string{}
+	for _, c := range commits {
+		list = append(list, c.Sha)
+	}
+
+	return list
+}
@@ -0,0 +1,288 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// UnreleasedSection is the name of the section of a changelog that lists the changes that have not been released yet.
+const UnreleasedSection = "Unreleased"
+
+const keepAChangelogPreamble = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.0.0/),
+and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).
+
+## [Unreleased]
+`
+
+// keepAChangelogCategories are the categories of changes of a changelog section, in the order they are listed.
+var keepAChangelogCategories = []string{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"}
+
+// keepAChangelogTypes maps the conventional commit types to the categories of changes. The types that are not
+// included are not listed in the changelog, apart from the commits that do not follow the specification.
+var keepAChangelogTypes = map[string]string{
+	FeatureType: "Added",
+	"perf":      "Changed",
+	"refactor":  "Changed",
+	"deprecate": "Deprecated",
+	"revert":    "Changed",
+	"remove":    "Removed",
+	FixType:     "Fixed",
+	"security":  "Security",
+	"":          "Changed",
+}
+
+var (
+	sectionHeaderRegex = regexp.MustCompile(`^##\s+\[([^\]]+)\]`)
+	linkReferenceRegex = regexp.MustCompile(`^\[([^\]]+)\]:\s*(\S+)`)
+)
+
+// changelogSection describes a section of a changelog along with the lines it consists of, including its header.
+type changelogSection struct {
+	name  string
+	lines []string
+}
+
+// linkReference describes a link reference definition of a changelog, like the ones of the sections.
+type linkReference struct {
+	name string
+	url  string
+}
+
+// changelogDocument describes a changelog split in the preamble, the sections and the trailing link references.
+type changelogDocument struct {
+	preamble []string
+	sections []changelogSection
+	links    []linkReference
+}
+
+// RenderKeepAChangelogSection renders the section of a release in the Keep a Changelog format. The commits are listed
+// in the category of changes that their conventional commit type maps to. The breaking changes are listed as changes,
+// while the commits of types like 'docs' or 'chore' are not listed at all.
+func RenderKeepAChangelogSection(tag string, date time.Time, commitList []domain.Commit) string {
+	entries := make(map[string][]string)
+	for _, c := range commitList {
+		commit := parseCommit(c)
+
+		category, ok := keepAChangelogTypes[commit.Type]
+		if commit.Scope == "security" {
+			category, ok = "Security", true
+		}
+		if commit.Breaking {
+			category, ok = "Changed", true
+		}
+		if !ok {
+			continue
+		}
+
+		entries[category] = append(entries[category], formatKeepAChangelogEntry(commit))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## [%v] - %v\n", tag, date.Format("2006-01-02")))
+
+	for _, category := range keepAChangelogCategories {
+		if len(entries[category]) == 0 {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("\n### %v\n\n", category))
+		for _, e := range entries[category] {
+			sb.WriteString(fmt.Sprintf("- %v\n", e))
+		}
+	}
+
+	return sb.String()
+}
+
+// InsertKeepAChangelogSection inserts the section of a release in the content of a changelog, right below the sections
+// of the higher versions, replacing any existing section of the same release. The rest of the sections, including the
+// unreleased one, are kept as they are. In case the url of the repository is provided, the link references of the
+// release, of the release right above it and of the unreleased section are set to compare the respective versions.
+func InsertKeepAChangelogSection(content, tag, section, repositoryUrl string) string {
+	if strings.TrimSpace(content) == "" {
+		content = keepAChangelogPreamble
+	}
+
+	doc := parseChangelog(content)
+	newSection := changelogSection{name: tag, lines: strings.Split(strings.TrimRight(section, "\n"), "\n")}
+
+	pos := -1
+	for idx, s := range doc.sections {
+		if s.name == tag {
+			pos = idx
+			doc.sections = append(doc.sections[:idx], doc.sections[idx+1:]...)
+			break
+		}
+	}
+
+	if pos < 0 {
+		pos = 0
+		if len(doc.sections) > 0 && strings.EqualFold(doc.sections[0].name, UnreleasedSection) {
+			pos = 1
+		}
+
+		for pos < len(doc.sections) && isHigherVersion(doc.sections[pos].name, tag) {
+			pos++
+		}
+	}
+
+	doc.sections = append(doc.sections[:pos], append([]changelogSection{newSection}, doc.sections[pos:]...)...)
+
+	if repositoryUrl != "" {
+		doc.setReleaseLink(pos, repositoryUrl)
+		if pos > 0 {
+			doc.setReleaseLink(pos-1, repositoryUrl)
+		}
+	}
+
+	return doc.render()
+}
+
+// formatKeepAChangelogEntry formats a commit as an entry of a changelog section, linking to its pull request or, in
+// case there is none, to the commit itself.
+func formatKeepAChangelogEntry(commit domain.ConventionalCommit) string {
+	entry := commit.Description
+	if commit.Breaking {
+		entry = fmt.Sprintf("**BREAKING:** %v", commit.BreakingDescription)
+	}
+
+	if commit.Scope != "" {
+		entry = fmt.Sprintf("**%v:** %v", commit.Scope, entry)
+	}
+
+	switch {
+	case commit.PullRequestUrl != "":
+		entry = fmt.Sprintf("%v ([#%v](%v))", entry, commit.PullRequestNumber, commit.PullRequestUrl)
+	case commit.Url != "":
+		entry = fmt.Sprintf("%v ([%v](%v))", entry, commit.ShortSha, commit.Url)
+	}
+
+	return entry
+}
+
+// isHigherVersion checks whether the name of the section is a version that is higher than the one of the tag.
+func isHigherVersion(name, tag string) bool {
+	sectionVersion, err := ParseSemanticVersion(name)
+	if err != nil {
+		return false
+	}
+
+	tagVersion, err := ParseSemanticVersion(tag)
+	if err != nil {
+		return false
+	}
+
+	return CompareSemanticVersions(sectionVersion, tagVersion) > 0
+}
+
+// parseChangelog splits the content of a changelog in the preamble, the sections and the link references that follow
+// the last section.
+func parseChangelog(content string) changelogDocument {
+	lines := strings.Split(strings.ReplaceAll(strings.TrimRight(content, "\n"), "\r\n", "\n"), "\n")
+
+	var doc changelogDocument
+
+	end := len(lines)
+	for end > 0 {
+		line := strings.TrimSpace(lines[end-1])
+		match := linkReferenceRegex.FindStringSubmatch(line)
+		if line != "" && match == nil {
+			break
+		}
+
+		if match != nil {
+			doc.links = append([]linkReference{{name: match[1], url: match[2]}}, doc.links...)
+		}
+
+		end--
+	}
+
+	for _, line := range lines[:end] {
+		if match := sectionHeaderRegex.FindStringSubmatch(line); match != nil {
+			doc.sections = append(doc.sections, changelogSection{name: match[1], lines: []string{line}})
+			continue
+		}
+
+		if len(doc.sections) == 0 {
+			doc.preamble = append(doc.preamble, line)
+			continue
+		}
+
+		last := &doc.sections[len(doc.sections)-1]
+		last.lines = append(last.lines, line)
+	}
+
+	return doc
+}
+
+// setReleaseLink sets the link reference of the section at the provided position to compare its version with the one
+// of the section below it. The unreleased section is compared with the latest release instead.
+func (d *changelogDocument) setReleaseLink(pos int, repositoryUrl string) {
+	name := d.sections[pos].name
+
+	var url string
+	switch {
+	case strings.EqualFold(name, UnreleasedSection) && pos+1 < len(d.sections):
+		url = fmt.Sprintf("%v/compare/%v...HEAD", repositoryUrl, d.sections[pos+1].name)
+	case strings.EqualFold(name, UnreleasedSection):
+		return
+	case pos+1 < len(d.sections):
+		url = fmt.Sprintf("%v/compare/%v...%v", repositoryUrl, d.sections[pos+1].name, name)
+	default:
+		url = fmt.Sprintf("%v/releases/tag/%v", repositoryUrl, name)
+	}
+
+	for idx, l := range d.links {
+		if strings.EqualFold(l.name, name) {
+			d.links[idx].url = url
+			return
+		}
+	}
+
+	d.links = append(d.links, linkReference{name: name, url: url})
+}
+
+// render renders the changelog, keeping a blank line between its parts. The link references of the sections are
+// listed in the order of the sections, followed by any other link reference.
+func (d *changelogDocument) render() string {
+	var parts []string
+
+	if preamble := strings.TrimSpace(strings.Join(d.preamble, "\n")); preamble != "" {
+		parts = append(parts, strings.TrimRight(strings.Join(d.preamble, "\n"), "\n "))
+	}
+
+	for _, s := range d.sections {
+		parts = append(parts, strings.TrimRight(strings.Join(s.lines, "\n"), "\n "))
+	}
+
+	var links []string
+	listedLinks := make(map[int]bool)
+	for _, s := range d.sections {
+		for idx, l := range d.links {
+			if !listedLinks[idx] && strings.EqualFold(l.name, s.name) {
+				listedLinks[idx] = true
+				links = append(links, fmt.Sprintf("[%v]: %v", l.name, l.url))
+			}
+		}
+	}
+
+	for idx, l := range d.links {
+		if !listedLinks[idx] {
+			links = append(links, fmt.Sprintf("[%v]: %v", l.name, l.url))
+		}
+	}
+
+	if len(links) > 0 {
+		parts = append(parts, strings.Join(links, "\n"))
+	}
+
+	return strings.Join(parts, "\n\n") + "\n"
+}
@@ -0,0 +1,136 @@
+package changelog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+func TestRenderKeepAChangelogSection(t *testing.T) {
+	commit := func(sha, message string) domain.Commit {
+		return domain.Commit{Sha: sha, HtmlUrl: "https://github.com/o/r/commit/" + sha, Details: domain.CommitDetails{Message: message}}
+	}
+
+	commitList := []domain.Commit{
+		commit("1111111aaaa", "feat(api): add tags endpoint (#3)"),
+		commit("2222222bbbb", "fix: handle nil"),
+		commit("3333333cccc", "docs: update readme"),
+		commit("4444444dddd", "refactor!: drop the v1 client (#4)"),
+		commit("5555555eeee", "fix(security): escape the output"),
+		commit("6666666ffff", "Update dependencies"),
+		commit("7777777aaaa", "revert: add the cache (#5)"),
+	}
+
+	expected := "## [v1.2.0] - 2021-03-01\n" +
+		"\n### Added\n\n" +
+		"- **api:** add tags endpoint ([#3](https://github.com/o/r/pull/3))\n" +
+		"\n### Changed\n\n" +
+		"- **BREAKING:** drop the v1 client ([#4](https://github.com/o/r/pull/4))\n" +
+		"- Update dependencies ([6666666](https://github.com/o/r/commit/6666666ffff))\n" +
+		"- add the cache ([#5](https://github.com/o/r/pull/5))\n" +
+		"\n### Fixed\n\n" +
+		"- handle nil ([2222222](https://github.com/o/r/commit/2222222bbbb))\n" +
+		"\n### Security\n\n" +
+		"- **security:** escape the output ([5555555](https://github.com/o/r/commit/5555555eeee))\n"
+
+	actual := changelog.RenderKeepAChangelogSection("v1.2.0", time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC), commitList)
+	if actual != expected {
+		t.Errorf("Expected to get '%v' as section, but got '%v'", expected, actual)
+	}
+}
+
+func TestInsertKeepAChangelogSection(t *testing.T) {
+	const repositoryUrl = "https://github.com/o/r"
+
+	existing := "# Changelog\n\n" +
+		"## [Unreleased]\n\n- Pending change\n\n" +
+		"## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something\n\n" +
+		"## [v1.0.0] - 2021-01-01\n\n### Added\n\n- Initial release\n\n" +
+		"[Unreleased]: https://github.com/o/r/compare/v1.1.0...HEAD\n" +
+		"[v1.1.0]: https://github.com/o/r/compare/v1.0.0...v1.1.0\n" +
+		"[v1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n"
+
+	testCases := map[string]struct {
+		content  string
+		tag      string
+		section  string
+		expected string
+	}{
+		"New latest release": {
+			content: existing,
+			tag:     "v1.2.0",
+			section: "## [v1.2.0] - 2021-03-01\n\n### Fixed\n\n- A fix\n",
+			expected: "# Changelog\n\n" +
+				"## [Unreleased]\n\n- Pending change\n\n" +
+				"## [v1.2.0] - 2021-03-01\n\n### Fixed\n\n- A fix\n\n" +
+				"## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something\n\n" +
+				"## [v1.0.0] - 2021-01-01\n\n### Added\n\n- Initial release\n\n" +
+				"[Unreleased]: https://github.com/o/r/compare/v1.2.0...HEAD\n" +
+				"[v1.2.0]: https://github.com/o/r/compare/v1.1.0...v1.2.0\n" +
+				"[v1.1.0]: https://github.com/o/r/compare/v1.0.0...v1.1.0\n" +
+				"[v1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+		},
+		"Release between existing releases": {
+			content: existing,
+			tag:     "v1.0.1",
+			section: "## [v1.0.1] - 2021-01-15\n\n### Fixed\n\n- A fix\n",
+			expected: "# Changelog\n\n" +
+				"## [Unreleased]\n\n- Pending change\n\n" +
+				"## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something\n\n" +
+				"## [v1.0.1] - 2021-01-15\n\n### Fixed\n\n- A fix\n\n" +
+				"## [v1.0.0] - 2021-01-01\n\n### Added\n\n- Initial release\n\n" +
+				"[Unreleased]: https://github.com/o/r/compare/v1.1.0...HEAD\n" +
+				"[v1.1.0]: https://github.com/o/r/compare/v1.0.1...v1.1.0\n" +
+				"[v1.0.1]: https://github.com/o/r/compare/v1.0.0...v1.0.1\n" +
+				"[v1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+		},
+		"Headings that are not releases are kept in the preamble": {
+			content: "# Changelog\n\n## Notes\n\nSome notes.\n\n" +
+				"## [v1.0.0] - 2021-01-01\n\n### Added\n\n- Initial release\n\n" +
+				"[v1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+			tag:     "v1.1.0",
+			section: "## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something\n",
+			expected: "# Changelog\n\n## Notes\n\nSome notes.\n\n" +
+				"## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something\n\n" +
+				"## [v1.0.0] - 2021-01-01\n\n### Added\n\n- Initial release\n\n" +
+				"[v1.1.0]: https://github.com/o/r/compare/v1.0.0...v1.1.0\n" +
+				"[v1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+		},
+		"Existing release is replaced": {
+			content: existing,
+			tag:     "v1.1.0",
+			section: "## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something else\n",
+			expected: "# Changelog\n\n" +
+				"## [Unreleased]\n\n- Pending change\n\n" +
+				"## [v1.1.0] - 2021-02-01\n\n### Added\n\n- Something else\n\n" +
+				"## [v1.0.0] - 2021-01-01\n\n### Added\n\n- Initial release\n\n" +
+				"[Unreleased]: https://github.com/o/r/compare/v1.1.0...HEAD\n" +
+				"[v1.1.0]: https://github.com/o/r/compare/v1.0.0...v1.1.0\n" +
+				"[v1.0.0]: https://github.com/o/r/releases/tag/v1.0.0\n",
+		},
+		"Empty changelog": {
+			content: "",
+			tag:     "v0.1.0",
+			section: "## [v0.1.0] - 2021-01-01\n",
+			expected: "# Changelog\n\n" +
+				"All notable changes to this project will be documented in this file.\n\n" +
+				"The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.0.0/),\n" +
+				"and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).\n\n" +
+				"## [Unreleased]\n\n" +
+				"## [v0.1.0] - 2021-01-01\n\n" +
+				"[Unreleased]: https://github.com/o/r/compare/v0.1.0...HEAD\n" +
+				"[v0.1.0]: https://github.com/o/r/releases/tag/v0.1.0\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := changelog.InsertKeepAChangelogSection(tc.content, tc.tag, tc.section, repositoryUrl)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as changelog, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const (
+	// BumpMajor is the bump of the major version, caused by breaking changes.
+	BumpMajor = "major"
+	// BumpMinor is the bump of the minor version, caused by new features.
+	BumpMinor = "minor"
+	// BumpPatch is the bump of the patch version, used for any other change.
+	BumpPatch = "patch"
+)
+
+var versionRegex = regexp.MustCompile(`^([^\d]*)(\d+)\.(\d+)\.(\d+)(.*)$`)
+
+// preReleaseRegex matches the pre-release identifiers at the start of the suffix of a version, like '-rc.1', which are
+// told apart from the rest of the suffix, like the '-svc' service initials, by their well known names.
+var preReleaseRegex = regexp.MustCompile(`^-((?i:alpha|beta|rc|pre|preview|dev)[0-9A-Za-z.]*)(.*)$`)
+
+var bumpPriority = map[string]int{
+	BumpPatch: 1,
+	BumpMinor: 2,
+	BumpMajor: 3,
+}
+
+// ParseSemanticVersion parses a tag to a semantic version. Anything before the version (like 'v') is kept as prefix, the
+// pre-release identifiers (like '-rc.1') are kept apart and anything after them (like the '-svc' service initials) is
+// kept as suffix.
+func ParseSemanticVersion(tag string) (domain.SemanticVersion, error) {
+	match := versionRegex.FindStringSubmatch(strings.TrimSpace(tag))
+	if match == nil {
+		return domain.SemanticVersion{}, fmt.Errorf("tag %q is not a semantic version", tag)
+	}
+
+	major, _ := strconv.Atoi(match[2])
+	minor, _ := strconv.Atoi(match[3])
+	patch, _ := strconv.Atoi(match[4])
+
+	version := domain.SemanticVersion{
+		Prefix: match[1],
+		Major:  major,
+		Minor:  minor,
+		Patch:  patch,
+		Suffix: match[5],
+	}
+
+	if preReleaseMatch := preReleaseRegex.FindStringSubmatch(match[5]); preReleaseMatch != nil {
+		version.PreRelease = preReleaseMatch[1]
+		version.Suffix = preReleaseMatch[2]
+	}
+
+	return version, nil
+}
+
+// DetermineBump determines the bump of the version that the commits and the labels of their pull requests require. A
+// breaking change or a 'major' label requires a major bump, a feature or a 'minor' label requires a minor bump and
+// anything else a patch one.
+func DetermineBump(commits []domain.ConventionalCommit, labels []string) string {
+	bump := BumpPatch
+
+	for _, c := range commits {
+		switch {
+		case c.Breaking:
+			bump = higherBump(bump, BumpMajor)
+		case c.Type == FeatureType:
+			bump = higherBump(bump, BumpMinor)
+		}
+	}
+
+	for _, l := range labels {
+		if _, ok := bumpPriority[strings.ToLower(l)]; ok {
+			bump = higherBump(bump, strings.ToLower(l))
+		}
+	}
+
+	return bump
+}
+
+// BumpVersion applies the bump to the version, resetting the lower parts of it. The bump of a pre-release version
+// drops its pre-release identifiers instead, unless the bump is higher than the one that the pre-release version has
+// already been bumped with.
+func BumpVersion(version domain.SemanticVersion, bump string) domain.SemanticVersion {
+	if version.PreRelease != "" {
+		version.PreRelease = ""
+		if bumpPriority[bump] <= bumpPriority[getAppliedBump(version)] {
+			return version
+		}
+	}
+
+	switch bump {
+	case BumpMajor:
+		version.Major++
+		version.Minor, version.Patch = 0, 0
+	case BumpMinor:
+		version.Minor++
+		version.Patch = 0
+	default:
+		version.Patch++
+	}
+
+	return version
+}
+
+// SuggestNextVersion suggests the tag of the next version based on the latest tag, the commits since then and the
+// labels of their pull requests. The bump that was applied is returned as well.
+func SuggestNextVersion(latestTag string, commits []domain.ConventionalCommit, labels []string) (string, string, error) {
+	version, err := ParseSemanticVersion(latestTag)
+	if err != nil {
+		return "", "", err
+	}
+
+	// The breaking changes of a version before 1.0.0 only bump the minor version, while a 'major' label still bumps the
+	// major one.
+	bump := DetermineBump(commits, nil)
+	if version.Major == 0 && bump == BumpMajor {
+		bump = BumpMinor
+	}
+	bump = higherBump(bump, DetermineBump(nil, labels))
+
+	return BumpVersion(version, bump).String(), bump, nil
+}
+
+// CompareSemanticVersions compares the major, minor and patch parts of the versions and returns a negative number in
+// case the first version is lower, a positive one in case it is higher and zero in case they are equal. A pre-release
+// version is lower than the version without the pre-release identifiers, and the pre-releases of the same version are
+// compared by their identifiers.
+func CompareSemanticVersions(a, b domain.SemanticVersion) int {
+	switch {
+	case a.Major != b.Major:
+		return a.Major - b.Major
+	case a.Minor != b.Minor:
+		return a.Minor - b.Minor
+	case a.Patch != b.Patch:
+		return a.Patch - b.Patch
+	case a.PreRelease == b.PreRelease:
+		return 0
+	case a.PreRelease == "":
+		return 1
+	case b.PreRelease == "":
+		return -1
+	default:
+		return comparePreReleases(a.PreRelease, b.PreRelease)
+	}
+}
+
+// comparePreReleases compares the dot separated identifiers of two pre-releases one by one. The numeric identifiers are
+// compared as numbers and are lower than the alphanumeric ones, which are compared lexically. In case all of them are
+// equal, the pre-release with the fewer identifiers is the lower one.
+func comparePreReleases(a, b string) int {
+	aIdentifiers := strings.Split(a, ".")
+	bIdentifiers := strings.Split(b, ".")
+
+	for idx := 0; idx < len(aIdentifiers) && idx < len(bIdentifiers); idx++ {
+		aNumber, aErr := strconv.Atoi(aIdentifiers[idx])
+		bNumber, bErr := strconv.Atoi(bIdentifiers[idx])
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNumber != bNumber {
+				return aNumber - bNumber
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if result := strings.Compare(aIdentifiers[idx], bIdentifiers[idx]); result != 0 {
+				return result
+			}
+		}
+	}
+
+	return len(aIdentifiers) - len(bIdentifiers)
+}
+
+// SortVersionTags returns the names of the tags that are semantic versions, sorted from the lowest to the highest
+// version.
+func SortVersionTags(tags []domain.Tag) []string {
+	var versionTags []string
+	versions := make(map[string]domain.SemanticVersion)
+
+	for _, t := range tags {
+		version, err := ParseSemanticVersion(t.Name)
+		if err != nil {
+			continue
+		}
+
+		versions[t.Name] = version
+		versionTags = append(versionTags, t.Name)
+	}
+
+	sort.SliceStable(versionTags, func(i, j int) bool {
+		return CompareSemanticVersions(versions[versionTags[i]], versions[versionTags[j]]) < 0
+	})
+
+	return versionTags
+}
+
+// getAppliedBump returns the bump that the version has been released with, based on the lowest part of it that is set.
+func getAppliedBump(version domain.SemanticVersion) string {
+	switch {
+	case version.Patch > 0:
+		return BumpPatch
+	case version.Minor > 0:
+		return BumpMinor
+	default:
+		return BumpMajor
+	}
+}
+
+// higherBump returns the bump with the highest priority.
+func higherBump(current, candidate string) string {
+	if bumpPriority[candidate] > bumpPriority[current] {
+		return candidate
+	}
+
+	return current
+}
@@ -0,0 +1,199 @@
+package changelog_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+func TestParseSemanticVersion(t *testing.T) {
+	testCases := map[string]struct {
+		tag           string
+		expected      domain.SemanticVersion
+		expectedError bool
+	}{
+		"Version with prefix": {
+			tag:      "v1.2.3",
+			expected: domain.SemanticVersion{Prefix: "v", Major: 1, Minor: 2, Patch: 3},
+		},
+		"Version with prefix and service initials": {
+			tag:      "v1.12.0-svc",
+			expected: domain.SemanticVersion{Prefix: "v", Major: 1, Minor: 12, Patch: 0, Suffix: "-svc"},
+		},
+		"Pre-release version with service initials": {
+			tag:      "v1.2.0-rc.1-svc",
+			expected: domain.SemanticVersion{Prefix: "v", Major: 1, Minor: 2, Patch: 0, PreRelease: "rc.1", Suffix: "-svc"},
+		},
+		"Version without prefix": {
+			tag:      "0.4.10",
+			expected: domain.SemanticVersion{Major: 0, Minor: 4, Patch: 10},
+		},
+		"Tag that is not a version": {
+			tag:           "latest",
+			expected:      domain.SemanticVersion{},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := changelog.ParseSemanticVersion(tc.tag)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%+v' as version, but got '%+v'", tc.expected, actual)
+			}
+
+			if tc.expectedError != (err != nil) {
+				t.Errorf("Expected to get an error to be '%v', but got '%v'", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSuggestNextVersion(t *testing.T) {
+	type expected struct {
+		version string
+		bump    string
+	}
+
+	testCases := map[string]struct {
+		latestTag string
+		commits   []domain.ConventionalCommit
+		labels    []string
+		expected  expected
+	}{
+		"Fixes only": {
+			latestTag: "v1.2.3-svc",
+			commits:   []domain.ConventionalCommit{{Type: "fix"}, {Type: "chore"}},
+			expected:  expected{version: "v1.2.4-svc", bump: changelog.BumpPatch},
+		},
+		"Commits that do not follow the specification": {
+			latestTag: "v1.2.3",
+			commits:   []domain.ConventionalCommit{{Description: "Update README.md"}},
+			expected:  expected{version: "v1.2.4", bump: changelog.BumpPatch},
+		},
+		"New feature": {
+			latestTag: "v1.2.3-svc",
+			commits:   []domain.ConventionalCommit{{Type: "fix"}, {Type: "feat"}},
+			expected:  expected{version: "v1.3.0-svc", bump: changelog.BumpMinor},
+		},
+		"Breaking change": {
+			latestTag: "v1.2.3",
+			commits:   []domain.ConventionalCommit{{Type: "feat"}, {Type: "fix", Breaking: true}},
+			expected:  expected{version: "v2.0.0", bump: changelog.BumpMajor},
+		},
+		"Minor label of a pull request": {
+			latestTag: "v1.2.3",
+			commits:   []domain.ConventionalCommit{{Type: "fix"}},
+			labels:    []string{"bug", "Minor"},
+			expected:  expected{version: "v1.3.0", bump: changelog.BumpMinor},
+		},
+		"Major label of a pull request": {
+			latestTag: "v1.2.3",
+			commits:   []domain.ConventionalCommit{{Type: "feat"}},
+			labels:    []string{"major"},
+			expected:  expected{version: "v2.0.0", bump: changelog.BumpMajor},
+		},
+		"Breaking change before the first major version": {
+			latestTag: "v0.4.1",
+			commits:   []domain.ConventionalCommit{{Type: "feat", Breaking: true}},
+			expected:  expected{version: "v0.5.0", bump: changelog.BumpMinor},
+		},
+		"Major label before the first major version": {
+			latestTag: "v0.4.1",
+			commits:   []domain.ConventionalCommit{{Type: "feat", Breaking: true}},
+			labels:    []string{"major"},
+			expected:  expected{version: "v1.0.0", bump: changelog.BumpMajor},
+		},
+		"Fixes after a pre-release": {
+			latestTag: "v1.2.0-rc.1",
+			commits:   []domain.ConventionalCommit{{Type: "fix"}},
+			expected:  expected{version: "v1.2.0", bump: changelog.BumpPatch},
+		},
+		"Breaking change after a pre-release of a minor version": {
+			latestTag: "v1.2.0-rc.1",
+			commits:   []domain.ConventionalCommit{{Type: "fix", Breaking: true}},
+			expected:  expected{version: "v2.0.0", bump: changelog.BumpMajor},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			version, bump, err := changelog.SuggestNextVersion(tc.latestTag, tc.commits, tc.labels)
+			if err != nil {
+				t.Errorf("Expected to get nil as error, but got '%v'", err)
+			}
+
+			actual := expected{version: version, bump: bump}
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%+v' as suggestion, but got '%+v'", tc.expected, actual)
+			}
+		})
+	}
+
+	t.Run("Provide a latest tag that is not a version - expecting an error", func(t *testing.T) {
+		_, _, err := changelog.SuggestNextVersion("main", []domain.ConventionalCommit{}, []string{})
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestCompareSemanticVersions(t *testing.T) {
+	testCases := map[string]struct {
+		a        domain.SemanticVersion
+		b        domain.SemanticVersion
+		expected int
+	}{
+		"Lower minor version": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, Patch: 5},
+			b:        domain.SemanticVersion{Major: 1, Minor: 3},
+			expected: -1,
+		},
+		"Pre-release of the same version": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.1"},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2},
+			expected: -1,
+		},
+		"Later pre-release of the same version": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.2"},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.1"},
+			expected: 1,
+		},
+		"Pre-release with a numeric identifier of more digits": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.2"},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.10"},
+			expected: -1,
+		},
+		"Pre-release with a numeric identifier of fewer digits": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.10"},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "rc.2"},
+			expected: 1,
+		},
+		"Pre-release with a numeric identifier against an alphanumeric one": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "alpha.1"},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "alpha.beta"},
+			expected: -1,
+		},
+		"Pre-release with fewer identifiers": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "alpha"},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2, PreRelease: "alpha.1"},
+			expected: -1,
+		},
+		"Same version": {
+			a:        domain.SemanticVersion{Major: 1, Minor: 2},
+			b:        domain.SemanticVersion{Major: 1, Minor: 2},
+			expected: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := changelog.CompareSemanticVersions(tc.a, tc.b)
+			if (actual < 0 && tc.expected >= 0) || (actual > 0 && tc.expected <= 0) || (actual == 0 && tc.expected != 0) {
+				t.Errorf("Expected to get a result with the sign of '%v', but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
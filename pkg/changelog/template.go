@@ -0,0 +1,110 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const ellipsis = "..."
+
+// TemplateFuncs returns the helper functions that are available in the templates.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"firstLine":     FirstLine,
+		"truncate":      Truncate,
+		"shortSha":      ShortSha,
+		"prNumber":      PullRequestNumber,
+		"prLink":        PullRequestLink,
+		"groupByAuthor": GroupByAuthor,
+		"groupByDate":   GroupByDate,
+	}
+}
+
+// FirstLine returns the first line of the text.
+func FirstLine(text string) string {
+	return strings.TrimSpace(strings.SplitN(strings.TrimSpace(text), "\n", 2)[0])
+}
+
+// Truncate shortens the text to the provided number of characters, including the ellipsis that replaces the rest of
+// it. The length is first so that the text can be piped into it.
+func Truncate(length int, text string) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+
+	if length <= len(ellipsis) {
+		return string(runes[:length])
+	}
+
+	return string(runes[:length-len(ellipsis)]) + ellipsis
+}
+
+// ShortSha returns the abbreviated form of the sha of a commit.
+func ShortSha(sha string) string {
+	if len(sha) > shortShaLength {
+		return sha[:shortShaLength]
+	}
+
+	return sha
+}
+
+// PullRequestNumber returns the number of the pull request that the message of the commit refers to, or 0 in case it
+// refers to none.
+func PullRequestNumber(commit domain.Commit) int {
+	return ParseCommitMessage(commit.Details.Message).PullRequestNumber
+}
+
+// PullRequestLink returns the url of the pull request that the message of the commit refers to, based on the url of
+// the commit. An empty string is returned in case the commit refers to no pull request.
+func PullRequestLink(commit domain.Commit) string {
+	number := PullRequestNumber(commit)
+	idx := strings.Index(commit.HtmlUrl, "/commit/")
+	if number == 0 || idx < 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%v/pull/%v", commit.HtmlUrl[:idx], number)
+}
+
+// GroupByAuthor groups the commits per author, in the order that each author first appears in the list.
+func GroupByAuthor(commitList []domain.Commit) []domain.CommitGroup {
+	return groupCommits(commitList, func(c domain.Commit) string {
+		if c.Author.Username != "" {
+			return c.Author.Username
+		}
+
+		return c.Details.Committer.Name
+	})
+}
+
+// GroupByDate groups the commits per date of commit, in the order that each date first appears in the list.
+func GroupByDate(commitList []domain.Commit) []domain.CommitGroup {
+	return groupCommits(commitList, func(c domain.Commit) string {
+		return c.Details.Committer.Date.Format("2006-01-02")
+	})
+}
+
+// groupCommits groups the commits based on the key that is returned for each one of them.
+func groupCommits(commitList []domain.Commit, keyFn func(c domain.Commit) string) []domain.CommitGroup {
+	groups := []domain.CommitGroup{}
+	groupIndex := make(map[string]int)
+
+	for _, c := range commitList {
+		key := keyFn(c)
+
+		idx, ok := groupIndex[key]
+		if !ok {
+			idx = len(groups)
+			groupIndex[key] = idx
+			groups = append(groups, domain.CommitGroup{Name: key, Commits: []domain.Commit{}})
+		}
+
+		groups[idx].Commits = append(groups[idx].Commits, c)
+	}
+
+	return groups
+}
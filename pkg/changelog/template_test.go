@@ -0,0 +1,112 @@
+package changelog_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/changelog"
+)
+
+func TestTruncate(t *testing.T) {
+	testCases := map[string]struct {
+		length   int
+		text     string
+		expected string
+	}{
+		"Text shorter than the length": {length: 10, text: "short", expected: "short"},
+		"Text longer than the length":  {length: 10, text: "a rather long text", expected: "a rathe..."},
+		"Length shorter than ellipsis": {length: 2, text: "text", expected: "te"},
+		"Multi-byte characters":        {length: 5, text: "ήταν εδώ", expected: "ήτ..."},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := changelog.Truncate(tc.length, tc.text)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as truncated text, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFirstLine(t *testing.T) {
+	actual := changelog.FirstLine("\nfix: handle nil (#5)\n\nSome details.")
+
+	expected := "fix: handle nil (#5)"
+	if actual != expected {
+		t.Errorf("Expected to get '%v' as first line, but got '%v'", expected, actual)
+	}
+}
+
+func TestPullRequestLink(t *testing.T) {
+	testCases := map[string]struct {
+		commit   domain.Commit
+		expected string
+	}{
+		"Squashed commit of a pull request": {
+			commit:   domain.Commit{HtmlUrl: "https://github.com/o/r/commit/abc", Details: domain.CommitDetails{Message: "fix: handle nil (#5)"}},
+			expected: "https://github.com/o/r/pull/5",
+		},
+		"Commit without a pull request": {
+			commit:   domain.Commit{HtmlUrl: "https://github.com/o/r/commit/abc", Details: domain.CommitDetails{Message: "fix: handle nil"}},
+			expected: "",
+		},
+		"Commit without url": {
+			commit:   domain.Commit{Details: domain.CommitDetails{Message: "fix: handle nil (#5)"}},
+			expected: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := changelog.PullRequestLink(tc.commit)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as pull request link, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGroupCommits(t *testing.T) {
+	commit := func(sha, author string, day int) domain.Commit {
+		return domain.Commit{
+			Sha:     sha,
+			Author:  domain.User{Username: author},
+			Details: domain.CommitDetails{Committer: domain.Committer{Name: "Committer", Date: time.Date(2021, 3, day, 9, 0, 0, 0, time.UTC)}},
+		}
+	}
+
+	commitList := []domain.Commit{commit("a", "bob", 1), commit("b", "alice", 2), commit("c", "bob", 2), commit("d", "", 3)}
+
+	groupShas := func(groups []domain.CommitGroup) []string {
+		shas := []string{}
+		for _, g := range groups {
+			groupShas := []string{}
+			for _, c := range g.Commits {
+				groupShas = append(groupShas, c.Sha)
+			}
+
+			shas = append(shas, fmt.Sprintf("%v:%v", g.Name, strings.Join(groupShas, ",")))
+		}
+
+		return shas
+	}
+
+	t.Run("Group by author", func(t *testing.T) {
+		expected := []string{"bob:a,c", "alice:b", "Committer:d"}
+		if actual := groupShas(changelog.GroupByAuthor(commitList)); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as groups, but got '%v'", expected, actual)
+		}
+	})
+
+	t.Run("Group by date", func(t *testing.T) {
+		expected := []string{"2021-03-01:a", "2021-03-02:b,c", "2021-03-03:d"}
+		if actual := groupShas(changelog.GroupByDate(commitList)); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as groups, but got '%v'", expected, actual)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"errors"
+	"io"
 	"net/http"
 	"time"
 
@@ -13,14 +14,37 @@ import (
 
 // Client describes the functions that muse be implemented by any client of the factory.
 type Client interface {
+	GetBranchReference(authToken, repoOwner, repository, branch string) (domain.GitReference, error)
 	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
+	GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
+	GetCommitPullRequests(authToken, repoOwner, repository, commitSha string) ([]domain.PullRequest, error)
+	GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
+	GetIssueComments(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.Comment, error)
+	GetIssueTimeline(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.TimelineEvent, error)
+	GetAuthenticatedUser(authToken string) (domain.User, error)
+	SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error)
 	GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error)
 	GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.CommitFile, error)
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+	GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error)
+	GetRepositoryTree(authToken, repoOwner, repository, ref string) (domain.RepositoryTree, error)
 	GetReviewStateOfPullRequest(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error)
+	GetOrganizationRepos(authToken, org string, pageSize, pageNumber int) ([]domain.Repository, error)
+	GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error)
+	GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error)
+	GetTeamRepos(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.Repository, error)
+	CreatePullRequest(authToken, repoOwner, repository, title, head, base, body string) (domain.PullRequest, error)
+	CreateReference(authToken, repoOwner, repository, branch, sha string) (domain.GitReference, error)
 	CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error
+	UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error)
+	DeleteRelease(authToken, repoOwner, repository string, releaseID int) error
+	UploadReleaseAsset(authToken, repoOwner, repository string, releaseID int, name, contentType string, content io.Reader, size int64) (domain.ReleaseAsset, error)
+	DeleteReleaseAsset(authToken, repoOwner, repository string, assetID int) error
+	RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error
+	UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha string) (domain.RepositoryContentUpdate, error)
 	GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
 	GetWorkflowExecutions(authToken, repoOwner, repository, startDateStr, endDateStr string, pageSize, pageNumber int) ([]domain.Workflow, error)
 	GetWorkflowsOfRepository(authToken, repoOwner, repository string) ([]domain.Workflow, error)
@@ -2,14 +2,17 @@ package http
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/eujoy/gitpr/internal/config"
 	"github.com/eujoy/gitpr/internal/domain"
@@ -29,6 +32,27 @@ func NewClient(httpClient *http.Client, configuration config.Config) *Client {
 	}
 }
 
+// GetBranchReference retrieves the reference of a branch, including the commit it points to.
+func (c *Client) GetBranchReference(authToken, repoOwner, repository, branch string) (domain.GitReference, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetBranchReference)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{branch}", branch, -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return domain.GitReference{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var reference domain.GitReference
+	err = c.getResponse(req, &reference, nil)
+
+	return reference, err
+}
+
 // GetCommitDetails to get the details of a commit.
 func (c *Client) GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error) {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetCommitDetails)
@@ -50,6 +74,77 @@ func (c *Client) GetCommitDetails(authToken, repoOwner, repository, commitSha st
 	return commitInfo, err
 }
 
+// GetCommitList retrieves the commits of a branch that have been created during a specific time period.
+func (c *Client) GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetCommitList)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{branch}", branch, -1)
+	URL = strings.Replace(URL, "{since}", since.UTC().Format(time.RFC3339), -1)
+	URL = strings.Replace(URL, "{until}", until.UTC().Format(time.RFC3339), -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.Commit{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var commitList []domain.Commit
+	err = c.getResponse(req, &commitList, nil)
+
+	return commitList, err
+}
+
+// GetCommitPullRequests retrieves the pull requests that a commit is associated with.
+func (c *Client) GetCommitPullRequests(authToken, repoOwner, repository, commitSha string) ([]domain.PullRequest, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetCommitPullRequests)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{commitSha}", commitSha, -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.PullRequest{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var pullRequests []domain.PullRequest
+	err = c.getResponse(req, &pullRequests, nil)
+
+	return pullRequests, err
+}
+
+// GetFileCommitList retrieves the commits of a branch that have modified a specific file since a point in time.
+func (c *Client) GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetFileCommitList)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{branch}", branch, -1)
+	URL = strings.Replace(URL, "{path}", url.QueryEscape(path), -1)
+	URL = strings.Replace(URL, "{since}", since.UTC().Format(time.RFC3339), -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.Commit{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var commitList []domain.Commit
+	err = c.getResponse(req, &commitList, nil)
+
+	return commitList, err
+}
+
 // GetDiffBetweenTags to get a list of commits.
 func (c *Client) GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error) {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetDiffBetweenTags)
@@ -75,6 +170,91 @@ func (c *Client) GetDiffBetweenTags(authToken, repoOwner, repository, existingTa
 	return compareTagsResponse, err
 }
 
+// GetIssueComments retrieves the comments of an issue or a pull request.
+func (c *Client) GetIssueComments(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.Comment, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetIssueComments)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{issueNumber}", strconv.Itoa(issueNumber), -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.Comment{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var comments []domain.Comment
+	err = c.getResponse(req, &comments, nil)
+
+	return comments, err
+}
+
+// GetIssueTimeline retrieves the timeline events of an issue or a pull request.
+func (c *Client) GetIssueTimeline(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.TimelineEvent, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetIssueTimeline)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{issueNumber}", strconv.Itoa(issueNumber), -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.TimelineEvent{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var events []domain.TimelineEvent
+	err = c.getResponse(req, &events, nil)
+
+	return events, err
+}
+
+// GetAuthenticatedUser retrieves the details of the authenticated user.
+func (c *Client) GetAuthenticatedUser(authToken string) (domain.User, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetAuthenticatedUser)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var user domain.User
+	err = c.getResponse(req, &user, nil)
+
+	return user, err
+}
+
+// SearchIssues retrieves the issues and pull requests that match a search query.
+func (c *Client) SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.SearchIssues)
+	URL = strings.Replace(URL, "{query}", url.QueryEscape(query), -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return domain.SearchIssuesResponse{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var searchIssuesResponse domain.SearchIssuesResponse
+	err = c.getResponse(req, &searchIssuesResponse, nil)
+
+	return searchIssuesResponse, err
+}
+
 // GetUserRepos retrieves all the user repositories from github.
 func (c *Client) GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error) {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetUserRepos)
@@ -140,6 +320,29 @@ func (c *Client) GetPullRequestsDetails(authToken, repoOwner, repository string,
 	return pullRequestDetails, err
 }
 
+// GetPullRequestFiles retrieves the files modified in a pull request.
+func (c *Client) GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.CommitFile, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetPullRequestFiles)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{pullRequestNumber}", strconv.Itoa(pullRequestNumber), -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.CommitFile{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var pullRequestFiles []domain.CommitFile
+	err = c.getResponse(req, &pullRequestFiles, nil)
+
+	return pullRequestFiles, err
+}
+
 // GetPullRequestsOfRepository retrieves the pull requests for a specified repo.
 func (c *Client) GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error) {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetUserPullRequestsForRepo)
@@ -148,6 +351,8 @@ func (c *Client) GetPullRequestsOfRepository(authToken, repoOwner, repository, b
 	URL = strings.Replace(URL, "{prState}", prState, -1)
 	if baseBranch != "" {
 		URL = strings.Replace(URL, "{baseBranch}", "base="+baseBranch, -1)
+	} else {
+		URL = strings.Replace(URL, "&{baseBranch}", "", -1)
 	}
 	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
 	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
@@ -167,6 +372,49 @@ func (c *Client) GetPullRequestsOfRepository(authToken, repoOwner, repository, b
 	return pullRequestResponse, err
 }
 
+// GetRepositoryContent retrieves the content of a file of a repository at a specific reference.
+func (c *Client) GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetRepositoryContent)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{path}", path, -1)
+	URL = strings.Replace(URL, "{ref}", ref, -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return domain.RepositoryContent{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var repositoryContent domain.RepositoryContent
+	err = c.getResponse(req, &repositoryContent, nil)
+
+	return repositoryContent, err
+}
+
+// GetRepositoryTree retrieves the files and directories of a repository at a specific reference.
+func (c *Client) GetRepositoryTree(authToken, repoOwner, repository, ref string) (domain.RepositoryTree, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetRepositoryTree)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{ref}", ref, -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return domain.RepositoryTree{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var repositoryTree domain.RepositoryTree
+	err = c.getResponse(req, &repositoryTree, nil)
+
+	return repositoryTree, err
+}
+
 // GetReviewStateOfPullRequest retrieves the reviews of a pull request.
 func (c *Client) GetReviewStateOfPullRequest(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error) {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetReviewStatusOfPullRequest)
@@ -188,6 +436,149 @@ func (c *Client) GetReviewStateOfPullRequest(authToken, repoOwner, repository st
 	return pullRequestReviews, err
 }
 
+// GetOrganizationRepos retrieves the repositories of an organization.
+func (c *Client) GetOrganizationRepos(authToken, org string, pageSize, pageNumber int) ([]domain.Repository, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetOrganizationRepos)
+	URL = strings.Replace(URL, "{org}", org, -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.Repository{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var repositories []domain.Repository
+	err = c.getResponse(req, &repositories, nil)
+
+	return repositories, err
+}
+
+// GetTagList retrieves the tags of a repository.
+func (c *Client) GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetTagList)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.Tag{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var tagList []domain.Tag
+	err = c.getResponse(req, &tagList, nil)
+
+	return tagList, err
+}
+
+// GetTeamMembers retrieves the members of a team of an organization.
+func (c *Client) GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetTeamMembers)
+	URL = strings.Replace(URL, "{org}", org, -1)
+	URL = strings.Replace(URL, "{teamSlug}", teamSlug, -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.User{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var teamMembers []domain.User
+	err = c.getResponse(req, &teamMembers, nil)
+
+	return teamMembers, err
+}
+
+// GetTeamRepos retrieves the repositories that a team of an organization has access to.
+func (c *Client) GetTeamRepos(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.Repository, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetTeamRepos)
+	URL = strings.Replace(URL, "{org}", org, -1)
+	URL = strings.Replace(URL, "{teamSlug}", teamSlug, -1)
+	URL = strings.Replace(URL, "{pageSize}", strconv.Itoa(pageSize), -1)
+	URL = strings.Replace(URL, "{pageNumber}", strconv.Itoa(pageNumber), -1)
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return []domain.Repository{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	var repositories []domain.Repository
+	err = c.getResponse(req, &repositories, nil)
+
+	return repositories, err
+}
+
+// CreatePullRequest makes a post request to github api to create a new pull request.
+func (c *Client) CreatePullRequest(authToken, repoOwner, repository, title, head, base, body string) (domain.PullRequest, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.PostCreatePullRequest)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+
+	values := map[string]interface{}{
+		"title": title,
+		"head":  head,
+		"base":  base,
+		"body":  body,
+	}
+	jsonValue, _ := json.Marshal(values)
+
+	req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	var pullRequest domain.PullRequest
+	err = c.getExpectedResponse(req, &pullRequest, http.StatusCreated)
+
+	return pullRequest, err
+}
+
+// CreateReference makes a post request to github api to create a new branch pointing to the provided commit.
+func (c *Client) CreateReference(authToken, repoOwner, repository, branch, sha string) (domain.GitReference, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.PostCreateReference)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+
+	values := map[string]interface{}{
+		"ref": fmt.Sprintf("refs/heads/%s", branch),
+		"sha": sha,
+	}
+	jsonValue, _ := json.Marshal(values)
+
+	req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return domain.GitReference{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	var reference domain.GitReference
+	err = c.getExpectedResponse(req, &reference, http.StatusCreated)
+
+	return reference, err
+}
+
 // CreateRelease makes a post request to github api to create a new release with description.
 func (c *Client) CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.PostCreateRelease)
@@ -224,6 +615,152 @@ func (c *Client) CreateRelease(authToken, repoOwner, repository, tagName string,
 	return err
 }
 
+// UpdateRelease makes a patch request to github api to update the provided fields of a release.
+func (c *Client) UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.PatchUpdateRelease)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{releaseID}", strconv.Itoa(releaseID), -1)
+
+	jsonValue, _ := json.Marshal(releaseUpdate)
+
+	req, err := http.NewRequest(http.MethodPatch, URL, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return domain.Release{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	var release domain.Release
+	err = c.getResponse(req, &release, nil)
+
+	return release, err
+}
+
+// DeleteRelease makes a delete request to github api to delete a release. The tag of the release is not deleted.
+func (c *Client) DeleteRelease(authToken, repoOwner, repository string, releaseID int) error {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.DeleteRelease)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{releaseID}", strconv.Itoa(releaseID), -1)
+
+	req, err := http.NewRequest(http.MethodDelete, URL, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	err = c.getNoContentResponse(req)
+
+	return err
+}
+
+// UploadReleaseAsset makes a post request to the upload url of github to attach a file to a release.
+func (c *Client) UploadReleaseAsset(authToken, repoOwner, repository string, releaseID int, name, contentType string, content io.Reader, size int64) (domain.ReleaseAsset, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.UploadUrl, c.configuration.Clients.Github.Endpoints.PostUploadReleaseAsset)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{releaseID}", strconv.Itoa(releaseID), -1)
+	URL = strings.Replace(URL, "{name}", url.QueryEscape(name), -1)
+
+	req, err := http.NewRequest(http.MethodPost, URL, content)
+	if err != nil {
+		return domain.ReleaseAsset{}, err
+	}
+
+	req.ContentLength = size
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+	req.Header.Set("Content-Type", contentType)
+
+	var releaseAsset domain.ReleaseAsset
+	err = c.getResponse(req, &releaseAsset, nil)
+
+	return releaseAsset, err
+}
+
+// DeleteReleaseAsset makes a delete request to github api to delete a file attached to a release.
+func (c *Client) DeleteReleaseAsset(authToken, repoOwner, repository string, assetID int) error {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.DeleteReleaseAsset)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{assetID}", strconv.Itoa(assetID), -1)
+
+	req, err := http.NewRequest(http.MethodDelete, URL, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+
+	err = c.getNoContentResponse(req)
+
+	return err
+}
+
+// RequestReviewers requests the review of a pull request from the provided users.
+func (c *Client) RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.PostRequestReviewers)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{pullRequestNumber}", strconv.Itoa(pullRequestNumber), -1)
+
+	values := map[string]interface{}{
+		"reviewers": reviewers,
+	}
+	jsonValue, _ := json.Marshal(values)
+
+	req, err := http.NewRequest(http.MethodPost, URL, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	err = c.getExpectedResponse(req, nil, http.StatusCreated)
+
+	return err
+}
+
+// UpdateRepositoryContent makes a put request to github api to create or update a file of a repository in a new commit.
+func (c *Client) UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha string) (domain.RepositoryContentUpdate, error) {
+	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.PutRepositoryContent)
+	URL = strings.Replace(URL, "{repoOwner}", repoOwner, -1)
+	URL = strings.Replace(URL, "{repository}", repository, -1)
+	URL = strings.Replace(URL, "{path}", path, -1)
+
+	values := map[string]interface{}{
+		"message": message,
+		"content": base64.StdEncoding.EncodeToString([]byte(content)),
+		"branch":  branch,
+	}
+	if sha != "" {
+		values["sha"] = sha
+	}
+	jsonValue, _ := json.Marshal(values)
+
+	req, err := http.NewRequest(http.MethodPut, URL, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return domain.RepositoryContentUpdate{}, err
+	}
+
+	req.Header.Add("Accept", c.configuration.Clients.Github.Headers.Accept)
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", authToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	var contentUpdate domain.RepositoryContentUpdate
+	err = c.getExpectedResponse(req, &contentUpdate, http.StatusOK, http.StatusCreated)
+
+	return contentUpdate, err
+}
+
 // GetReleaseList fetches the releases that have taken place in a repository.
 func (c *Client) GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error) {
 	URL := fmt.Sprintf("%s%s", c.configuration.Clients.Github.ApiUrl, c.configuration.Clients.Github.Endpoints.GetReleaseList)
@@ -374,6 +911,45 @@ func (c *Client) getResponse(req *http.Request, data interface{}, meta *domain.M
 	return nil
 }
 
+// getNoContentResponse makes a request that is expected to get an empty response, like the delete requests, and returns
+// an error in case the response has a different status.
+func (c *Client) getNoContentResponse(req *http.Request) error {
+	return c.getExpectedResponse(req, nil, http.StatusNoContent)
+}
+
+// getExpectedResponse makes a request that is expected to get a response of any of the provided statuses and parses the
+// response to the data, in case it is provided. The body of any other response is returned as an error.
+func (c *Client) getExpectedResponse(req *http.Request, data interface{}, expectedStatuses ...int) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	expected := false
+	for _, status := range expectedStatuses {
+		if resp.StatusCode == status {
+			expected = true
+			break
+		}
+	}
+
+	if !expected {
+		return fmt.Errorf("request %v %v failed with status %q : %v", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if data != nil {
+		return json.Unmarshal(body, data)
+	}
+
+	return nil
+}
+
 // parseMetaData prepares the metadata for the response.
 func parseMetaData(response *http.Response, meta *domain.Meta) error {
 	lastPage := 1
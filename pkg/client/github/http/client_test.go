@@ -0,0 +1,116 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/config"
+	client "github.com/eujoy/gitpr/pkg/client/github/http"
+)
+
+// newTestClient creates a client that sends all of its requests to a test server, which responds with the provided
+// status and body.
+func newTestClient(t *testing.T, status int, body string) *client.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	var cfg config.Config
+	cfg.Clients.Github.ApiUrl = server.URL
+	cfg.Clients.Github.Endpoints.PostRequestReviewers = "/repos/{repoOwner}/{repository}/pulls/{pullRequestNumber}/requested_reviewers"
+	cfg.Clients.Github.Endpoints.PostCreatePullRequest = "/repos/{repoOwner}/{repository}/pulls"
+	cfg.Clients.Github.Endpoints.PostCreateReference = "/repos/{repoOwner}/{repository}/git/refs"
+	cfg.Clients.Github.Endpoints.PutRepositoryContent = "/repos/{repoOwner}/{repository}/contents/{path}"
+
+	return client.NewClient(server.Client(), cfg)
+}
+
+func TestRequestReviewers(t *testing.T) {
+	t.Run("Reviewers requested", func(t *testing.T) {
+		c := newTestClient(t, http.StatusCreated, `{"number": 1}`)
+
+		err := c.RequestReviewers("token", "o", "r", 1, []string{"alice"})
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Reviewer that is not a collaborator - expecting an error", func(t *testing.T) {
+		c := newTestClient(t, http.StatusUnprocessableEntity, `{"message": "Reviews may only be requested from collaborators."}`)
+
+		err := c.RequestReviewers("token", "o", "r", 1, []string{"alice"})
+		if err == nil || !strings.Contains(err.Error(), "Reviews may only be requested from collaborators.") {
+			t.Errorf("Expected to get the body of the response as error, but got '%v'", err)
+		}
+	})
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	t.Run("Pull request created", func(t *testing.T) {
+		c := newTestClient(t, http.StatusCreated, `{"number": 1}`)
+
+		actualPullRequest, actualError := c.CreatePullRequest("token", "o", "r", "title", "head", "main", "body")
+		if actualPullRequest.Number != 1 {
+			t.Errorf("Expected to get '%v' as pull request number, but got '%v'", 1, actualPullRequest.Number)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Pull request that already exists - expecting an error", func(t *testing.T) {
+		c := newTestClient(t, http.StatusUnprocessableEntity, `{"message": "A pull request already exists for o:head."}`)
+
+		_, actualError := c.CreatePullRequest("token", "o", "r", "title", "head", "main", "body")
+		if actualError == nil || !strings.Contains(actualError.Error(), "A pull request already exists for o:head.") {
+			t.Errorf("Expected to get the body of the response as error, but got '%v'", actualError)
+		}
+	})
+}
+
+func TestCreateReference(t *testing.T) {
+	t.Run("Reference created", func(t *testing.T) {
+		c := newTestClient(t, http.StatusCreated, `{"ref": "refs/heads/release"}`)
+
+		actualReference, actualError := c.CreateReference("token", "o", "r", "release", "sha")
+		if actualReference.Ref != "refs/heads/release" {
+			t.Errorf("Expected to get '%v' as reference, but got '%v'", "refs/heads/release", actualReference.Ref)
+		}
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Reference that already exists - expecting an error", func(t *testing.T) {
+		c := newTestClient(t, http.StatusUnprocessableEntity, `{"message": "Reference already exists"}`)
+
+		_, actualError := c.CreateReference("token", "o", "r", "release", "sha")
+		if actualError == nil || !strings.Contains(actualError.Error(), "Reference already exists") {
+			t.Errorf("Expected to get the body of the response as error, but got '%v'", actualError)
+		}
+	})
+}
+
+func TestUpdateRepositoryContent(t *testing.T) {
+	t.Run("Content updated", func(t *testing.T) {
+		c := newTestClient(t, http.StatusOK, `{}`)
+
+		_, actualError := c.UpdateRepositoryContent("token", "o", "r", "CHANGELOG.md", "release", "message", "content", "sha")
+		if actualError != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", actualError)
+		}
+	})
+
+	t.Run("Content with an outdated sha - expecting an error", func(t *testing.T) {
+		c := newTestClient(t, http.StatusUnprocessableEntity, `{"message": "sha does not match"}`)
+
+		_, actualError := c.UpdateRepositoryContent("token", "o", "r", "CHANGELOG.md", "release", "message", "content", "sha")
+		if actualError == nil || !strings.Contains(actualError.Error(), "sha does not match") {
+			t.Errorf("Expected to get the body of the response as error, but got '%v'", actualError)
+		}
+	})
+}
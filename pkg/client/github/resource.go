@@ -1,18 +1,44 @@
 package github
 
 import (
+	"io"
+	"time"
+
 	"github.com/eujoy/gitpr/internal/domain"
 )
 
 type githubClient interface {
+	GetBranchReference(authToken, repoOwner, repository, branch string) (domain.GitReference, error)
 	GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error)
+	GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
+	GetCommitPullRequests(authToken, repoOwner, repository, commitSha string) ([]domain.PullRequest, error)
+	GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error)
+	GetIssueComments(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.Comment, error)
+	GetIssueTimeline(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.TimelineEvent, error)
+	GetAuthenticatedUser(authToken string) (domain.User, error)
+	SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error)
 	GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error)
 	GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error)
 	GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error)
+	GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.CommitFile, error)
 	GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error)
+	GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error)
+	GetRepositoryTree(authToken, repoOwner, repository, ref string) (domain.RepositoryTree, error)
 	GetReviewStateOfPullRequest(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error)
+	GetOrganizationRepos(authToken, org string, pageSize, pageNumber int) ([]domain.Repository, error)
+	GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error)
+	GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error)
+	GetTeamRepos(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.Repository, error)
+	CreatePullRequest(authToken, repoOwner, repository, title, head, base, body string) (domain.PullRequest, error)
+	CreateReference(authToken, repoOwner, repository, branch, sha string) (domain.GitReference, error)
 	CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error
+	UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error)
+	DeleteRelease(authToken, repoOwner, repository string, releaseID int) error
+	UploadReleaseAsset(authToken, repoOwner, repository string, releaseID int, name, contentType string, content io.Reader, size int64) (domain.ReleaseAsset, error)
+	DeleteReleaseAsset(authToken, repoOwner, repository string, assetID int) error
+	RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error
+	UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha string) (domain.RepositoryContentUpdate, error)
 	GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error)
 	GetWorkflowExecutions(authToken, repoOwner, repository, startDateStr, endDateStr string, pageSize, pageNumber int) ([]domain.Workflow, error)
 	GetWorkflowsOfRepository(authToken, repoOwner, repository string) ([]domain.Workflow, error)
@@ -32,18 +58,66 @@ func NewResource(githubClient githubClient) *Resource {
 	}
 }
 
+// GetBranchReference retrieves the reference of a branch, including the commit it points to.
+func (r *Resource) GetBranchReference(authToken, repoOwner, repository, branch string) (domain.GitReference, error) {
+	reference, err := r.githubClient.GetBranchReference(authToken, repoOwner, repository, branch)
+	return reference, err
+}
+
 // GetCommitDetails to get the details of a commit.
 func (r *Resource) GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error) {
 	commitDetails, err := r.githubClient.GetCommitDetails(authToken, repoOwner, repository, commitSha)
 	return commitDetails, err
 }
 
+// GetCommitList retrieves the commits of a branch that have been created during a specific time period.
+func (r *Resource) GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	commitList, err := r.githubClient.GetCommitList(authToken, repoOwner, repository, branch, since, until, pageSize, pageNumber)
+	return commitList, err
+}
+
+// GetCommitPullRequests retrieves the pull requests that a commit is associated with.
+func (r *Resource) GetCommitPullRequests(authToken, repoOwner, repository, commitSha string) ([]domain.PullRequest, error) {
+	commitPullRequests, err := r.githubClient.GetCommitPullRequests(authToken, repoOwner, repository, commitSha)
+	return commitPullRequests, err
+}
+
+// GetFileCommitList retrieves the commits of a branch that have modified a specific file since a point in time.
+func (r *Resource) GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	fileCommitList, err := r.githubClient.GetFileCommitList(authToken, repoOwner, repository, branch, path, since, pageSize, pageNumber)
+	return fileCommitList, err
+}
+
 // GetDiffBetweenTags to get a list of commits.
 func (r *Resource) GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error) {
 	diffBetweenTags, err := r.githubClient.GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag)
 	return diffBetweenTags, err
 }
 
+// GetIssueComments retrieves the comments of an issue or a pull request.
+func (r *Resource) GetIssueComments(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.Comment, error) {
+	comments, err := r.githubClient.GetIssueComments(authToken, repoOwner, repository, issueNumber, pageSize, pageNumber)
+	return comments, err
+}
+
+// GetIssueTimeline retrieves the timeline events of an issue or a pull request.
+func (r *Resource) GetIssueTimeline(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.TimelineEvent, error) {
+	timelineEvents, err := r.githubClient.GetIssueTimeline(authToken, repoOwner, repository, issueNumber, pageSize, pageNumber)
+	return timelineEvents, err
+}
+
+// GetAuthenticatedUser retrieves the details of the authenticated user.
+func (r *Resource) GetAuthenticatedUser(authToken string) (domain.User, error) {
+	user, err := r.githubClient.GetAuthenticatedUser(authToken)
+	return user, err
+}
+
+// SearchIssues retrieves the issues and pull requests that match a search query.
+func (r *Resource) SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error) {
+	searchIssuesResponse, err := r.githubClient.SearchIssues(authToken, query, pageSize, pageNumber)
+	return searchIssuesResponse, err
+}
+
 // GetUserRepos retrieves all the user repositories from GitHub.
 func (r *Resource) GetUserRepos(authToken string, pageSize int, pageNumber int) (domain.UserReposResponse, error) {
 	userRepos, err := r.githubClient.GetUserRepos(authToken, pageSize, pageNumber)
@@ -62,24 +136,114 @@ func (r *Resource) GetPullRequestsDetails(authToken, repoOwner, repository strin
 	return pullRequestDetails, err
 }
 
+// GetPullRequestFiles retrieves the files modified in a pull request.
+func (r *Resource) GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.CommitFile, error) {
+	pullRequestFiles, err := r.githubClient.GetPullRequestFiles(authToken, repoOwner, repository, pullRequestNumber, pageSize, pageNumber)
+	return pullRequestFiles, err
+}
+
 // GetPullRequestsOfRepository retrieves the pull requests for a specified repo.
 func (r *Resource) GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState string, pageSize int, pageNumber int) (domain.RepoPullRequestsResponse, error) {
 	pullRequests, err := r.githubClient.GetPullRequestsOfRepository(authToken, repoOwner, repository, baseBranch, prState, pageSize, pageNumber)
 	return pullRequests, err
 }
 
+// GetRepositoryContent retrieves the content of a file of a repository at a specific reference.
+func (r *Resource) GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error) {
+	repositoryContent, err := r.githubClient.GetRepositoryContent(authToken, repoOwner, repository, path, ref)
+	return repositoryContent, err
+}
+
+// GetRepositoryTree retrieves the files and directories of a repository at a specific reference.
+func (r *Resource) GetRepositoryTree(authToken, repoOwner, repository, ref string) (domain.RepositoryTree, error) {
+	repositoryTree, err := r.githubClient.GetRepositoryTree(authToken, repoOwner, repository, ref)
+	return repositoryTree, err
+}
+
 // GetReviewStateOfPullRequest retrieves the reviews of a pull request.
 func (r *Resource) GetReviewStateOfPullRequest(authToken, repoOwner, repository string, pullRequestNumber int) ([]domain.PullRequestReview, error) {
 	pullRequestReviews, err := r.githubClient.GetReviewStateOfPullRequest(authToken, repoOwner, repository, pullRequestNumber)
 	return pullRequestReviews, err
 }
 
+// GetOrganizationRepos retrieves the repositories of an organization.
+func (r *Resource) GetOrganizationRepos(authToken, org string, pageSize, pageNumber int) ([]domain.Repository, error) {
+	organizationRepos, err := r.githubClient.GetOrganizationRepos(authToken, org, pageSize, pageNumber)
+	return organizationRepos, err
+}
+
+// GetTagList retrieves the tags of a repository.
+func (r *Resource) GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error) {
+	tagList, err := r.githubClient.GetTagList(authToken, repoOwner, repository, pageSize, pageNumber)
+	return tagList, err
+}
+
+// GetTeamMembers retrieves the members of a team of an organization.
+func (r *Resource) GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error) {
+	teamMembers, err := r.githubClient.GetTeamMembers(authToken, org, teamSlug, pageSize, pageNumber)
+	return teamMembers, err
+}
+
+// UpdateRelease makes a patch request to github api to update the provided fields of a release.
+func (r *Resource) UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error) {
+	release, err := r.githubClient.UpdateRelease(authToken, repoOwner, repository, releaseID, releaseUpdate)
+	return release, err
+}
+
+// DeleteRelease makes a delete request to github api to delete a release. The tag of the release is not deleted.
+func (r *Resource) DeleteRelease(authToken, repoOwner, repository string, releaseID int) error {
+	err := r.githubClient.DeleteRelease(authToken, repoOwner, repository, releaseID)
+	return err
+}
+
+// UploadReleaseAsset makes a post request to the upload url of github to attach a file to a release.
+func (r *Resource) UploadReleaseAsset(authToken, repoOwner, repository string, releaseID int, name, contentType string, content io.Reader, size int64) (domain.ReleaseAsset, error) {
+	releaseAsset, err := r.githubClient.UploadReleaseAsset(authToken, repoOwner, repository, releaseID, name, contentType, content, size)
+	return releaseAsset, err
+}
+
+// DeleteReleaseAsset makes a delete request to github api to delete a file attached to a release.
+func (r *Resource) DeleteReleaseAsset(authToken, repoOwner, repository string, assetID int) error {
+	err := r.githubClient.DeleteReleaseAsset(authToken, repoOwner, repository, assetID)
+	return err
+}
+
+// RequestReviewers requests the review of a pull request from the provided users.
+func (r *Resource) RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error {
+	err := r.githubClient.RequestReviewers(authToken, repoOwner, repository, pullRequestNumber, reviewers)
+	return err
+}
+
+// GetTeamRepos retrieves the repositories that a team of an organization has access to.
+func (r *Resource) GetTeamRepos(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.Repository, error) {
+	teamRepos, err := r.githubClient.GetTeamRepos(authToken, org, teamSlug, pageSize, pageNumber)
+	return teamRepos, err
+}
+
+// CreatePullRequest makes a post request to github api to create a new pull request.
+func (r *Resource) CreatePullRequest(authToken, repoOwner, repository, title, head, base, body string) (domain.PullRequest, error) {
+	pullRequest, err := r.githubClient.CreatePullRequest(authToken, repoOwner, repository, title, head, base, body)
+	return pullRequest, err
+}
+
+// CreateReference makes a post request to github api to create a new branch pointing to the provided commit.
+func (r *Resource) CreateReference(authToken, repoOwner, repository, branch, sha string) (domain.GitReference, error) {
+	reference, err := r.githubClient.CreateReference(authToken, repoOwner, repository, branch, sha)
+	return reference, err
+}
+
 // CreateRelease is responsible for creating a release against a desired repository.
 func (r *Resource) CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error {
 	err := r.githubClient.CreateRelease(authToken, repoOwner, repository, tagName, draftRelease, name, body)
 	return err
 }
 
+// UpdateRepositoryContent makes a put request to github api to create or update a file of a repository in a new commit.
+func (r *Resource) UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha string) (domain.RepositoryContentUpdate, error) {
+	contentUpdate, err := r.githubClient.UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha)
+	return contentUpdate, err
+}
+
 // GetReleaseList fetches the releases that have taken place in a repository.
 func (r *Resource) GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error) {
 	releaseList, err := r.githubClient.GetReleaseList(authToken, repoOwner, repository, pageSize, pageNumber)
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"sort"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// BuildCategoryBreakdown calculates the share of the pull requests and of the modified lines per category. The
+// categories are reported in the provided order, while any unknown category of a pull request is appended at the end.
+func BuildCategoryBreakdown(prDetails []domain.PullRequestMetricDetails, categoryNames []string) []domain.CategoryBreakdown {
+	var breakdown []domain.CategoryBreakdown
+	categoryIndex := make(map[string]int)
+	for _, name := range categoryNames {
+		if _, ok := categoryIndex[name]; ok {
+			continue
+		}
+
+		categoryIndex[name] = len(breakdown)
+		breakdown = append(breakdown, domain.CategoryBreakdown{Category: name})
+	}
+
+	totalLines := 0
+	for _, pr := range prDetails {
+		idx, ok := categoryIndex[pr.Category]
+		if !ok {
+			idx = len(breakdown)
+			categoryIndex[pr.Category] = idx
+			breakdown = append(breakdown, domain.CategoryBreakdown{Category: pr.Category})
+		}
+
+		breakdown[idx].PullRequests++
+		breakdown[idx].Lines += pr.SizeLines
+		totalLines += pr.SizeLines
+	}
+
+	for idx := range breakdown {
+		if len(prDetails) > 0 {
+			breakdown[idx].PullRequestsPercentage = roundToTwoDecimals(float64(breakdown[idx].PullRequests) / float64(len(prDetails)) * 100)
+		}
+
+		if totalLines > 0 {
+			breakdown[idx].LinesPercentage = roundToTwoDecimals(float64(breakdown[idx].Lines) / float64(totalLines) * 100)
+		}
+	}
+
+	return breakdown
+}
+
+// BuildCategoryPeriods calculates the category breakdown of the pull requests that have been created during each period.
+func BuildCategoryPeriods(prDetails []domain.PullRequestMetricDetails, categoryNames []string, period string) []domain.CategoryPeriod {
+	prDetailsPerPeriod := make(map[string][]domain.PullRequestMetricDetails)
+	for _, pr := range prDetails {
+		periodKey := GetPeriodKey(pr.CreatedAt, period)
+		prDetailsPerPeriod[periodKey] = append(prDetailsPerPeriod[periodKey], pr)
+	}
+
+	periodKeys := make([]string, 0, len(prDetailsPerPeriod))
+	for k := range prDetailsPerPeriod {
+		periodKeys = append(periodKeys, k)
+	}
+	sort.Strings(periodKeys)
+
+	var categoryPeriods []domain.CategoryPeriod
+	for _, k := range periodKeys {
+		categoryPeriods = append(categoryPeriods, domain.CategoryPeriod{
+			Period:     k,
+			Categories: BuildCategoryBreakdown(prDetailsPerPeriod[k], categoryNames),
+		})
+	}
+
+	return categoryPeriods
+}
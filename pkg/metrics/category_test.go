@@ -0,0 +1,66 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestBuildCategoryBreakdown(t *testing.T) {
+	prDetails := []domain.PullRequestMetricDetails{
+		{Category: "feature", SizeLines: 300},
+		{Category: "feature", SizeLines: 100},
+		{Category: "bug", SizeLines: 100},
+		{Category: "other", SizeLines: 0},
+	}
+
+	expected := []domain.CategoryBreakdown{
+		{Category: "feature", PullRequests: 2, Lines: 400, PullRequestsPercentage: 50, LinesPercentage: 80},
+		{Category: "bug", PullRequests: 1, Lines: 100, PullRequestsPercentage: 25, LinesPercentage: 20},
+		{Category: "incident", PullRequests: 0, Lines: 0, PullRequestsPercentage: 0, LinesPercentage: 0},
+		{Category: "other", PullRequests: 1, Lines: 0, PullRequestsPercentage: 25, LinesPercentage: 0},
+	}
+
+	actual := metrics.BuildCategoryBreakdown(prDetails, []string{"feature", "bug", "incident"})
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as breakdown, but got '%+v'", expected, actual)
+	}
+}
+
+func TestBuildCategoryPeriods(t *testing.T) {
+	firstWeek := time.Date(2021, 1, 5, 10, 0, 0, 0, time.UTC)
+	secondWeek := time.Date(2021, 1, 12, 10, 0, 0, 0, time.UTC)
+
+	prDetails := []domain.PullRequestMetricDetails{
+		{Category: "bug", SizeLines: 100, CreatedAt: secondWeek},
+		{Category: "feature", SizeLines: 300, CreatedAt: firstWeek},
+		{Category: "bug", SizeLines: 100, CreatedAt: firstWeek},
+	}
+
+	expected := []domain.CategoryPeriod{
+		{
+			Period: "2021-W01",
+			Categories: []domain.CategoryBreakdown{
+				{Category: "feature", PullRequests: 1, Lines: 300, PullRequestsPercentage: 50, LinesPercentage: 75},
+				{Category: "bug", PullRequests: 1, Lines: 100, PullRequestsPercentage: 50, LinesPercentage: 25},
+			},
+		},
+		{
+			Period: "2021-W02",
+			Categories: []domain.CategoryBreakdown{
+				{Category: "feature", PullRequests: 0, Lines: 0, PullRequestsPercentage: 0, LinesPercentage: 0},
+				{Category: "bug", PullRequests: 1, Lines: 100, PullRequestsPercentage: 100, LinesPercentage: 100},
+			},
+		},
+	}
+
+	actual := metrics.BuildCategoryPeriods(prDetails, []string{"feature", "bug"}, metrics.PeriodWeek)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as periods, but got '%+v'", expected, actual)
+	}
+}
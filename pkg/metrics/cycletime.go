@@ -0,0 +1,165 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const (
+	stageDraft            = "draft"
+	stageWaitingForReview = "waiting_for_review"
+	stageInReview         = "in_review"
+)
+
+// GetCycleTimeStages splits the lifecycle of a pull request into the coding, draft, waiting for review, in review and
+// waiting to merge stages, based on the events of its timeline. The stages are calculated up to the provided end date,
+// which is the merge date for the merged pull requests.
+func GetCycleTimeStages(author string, createdAt, endAt time.Time, timelineEvents []domain.TimelineEvent) domain.CycleTimeStages {
+	events := make([]domain.TimelineEvent, len(timelineEvents))
+	copy(events, timelineEvents)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetDate().Before(events[j].GetDate())
+	})
+
+	var firstCommitAt, approvedAt time.Time
+	initialStage := stageWaitingForReview
+	draftEventFound := false
+	for _, e := range events {
+		switch e.Event {
+		case "committed":
+			if firstCommitAt.IsZero() || e.GetDate().Before(firstCommitAt) {
+				firstCommitAt = e.GetDate()
+			}
+		case "reviewed":
+			// Only an approval makes the pull request ready to merge, until any later change request.
+			if isReviewOf(e, author) && strings.EqualFold(e.State, "approved") {
+				approvedAt = e.GetDate()
+			}
+			if isReviewOf(e, author) && strings.EqualFold(e.State, "changes_requested") {
+				approvedAt = time.Time{}
+			}
+		case "ready_for_review", "convert_to_draft":
+			// The first draft related event reveals whether the pull request was opened as a draft.
+			if !draftEventFound && e.Event == "ready_for_review" {
+				initialStage = stageDraft
+			}
+			draftEventFound = true
+		}
+	}
+
+	stages := domain.CycleTimeStages{}
+	if !firstCommitAt.IsZero() && firstCommitAt.Before(createdAt) {
+		stages.Coding = createdAt.Sub(firstCommitAt)
+	}
+
+	currentStage := initialStage
+	stageStartedAt := createdAt
+	reviewed := false
+
+	for _, e := range events {
+		eventDate := e.GetDate()
+		if eventDate.Before(createdAt) {
+			continue
+		}
+
+		if eventDate.After(endAt) || e.Event == "merged" {
+			break
+		}
+
+		nextStage := currentStage
+		switch e.Event {
+		case "convert_to_draft":
+			nextStage = stageDraft
+		case "ready_for_review":
+			nextStage = stageWaitingForReview
+			if reviewed {
+				nextStage = stageInReview
+			}
+		case "review_requested":
+			// Requesting a review again after the changes have been addressed moves the pull request back to waiting.
+			if currentStage == stageInReview {
+				nextStage = stageWaitingForReview
+			}
+		case "reviewed":
+			if !isReviewOf(e, author) {
+				continue
+			}
+
+			reviewed = true
+			if currentStage != stageDraft {
+				nextStage = stageInReview
+			}
+		}
+
+		if nextStage != currentStage {
+			addStageDuration(&stages, currentStage, stageStartedAt, eventDate, approvedAt)
+			currentStage = nextStage
+			stageStartedAt = eventDate
+		}
+	}
+
+	addStageDuration(&stages, currentStage, stageStartedAt, endAt, approvedAt)
+
+	return stages
+}
+
+// AverageCycleTimeStages calculates the average time spent in each stage for the provided pull requests.
+func AverageCycleTimeStages(stagesList []domain.CycleTimeStages) domain.CycleTimeStages {
+	if len(stagesList) == 0 {
+		return domain.CycleTimeStages{}
+	}
+
+	total := domain.CycleTimeStages{}
+	for _, s := range stagesList {
+		total.Coding += s.Coding
+		total.Draft += s.Draft
+		total.WaitingForReview += s.WaitingForReview
+		total.InReview += s.InReview
+		total.WaitingToMerge += s.WaitingToMerge
+	}
+
+	count := time.Duration(len(stagesList))
+
+	return domain.CycleTimeStages{
+		Coding:           total.Coding / count,
+		Draft:            total.Draft / count,
+		WaitingForReview: total.WaitingForReview / count,
+		InReview:         total.InReview / count,
+		WaitingToMerge:   total.WaitingToMerge / count,
+	}
+}
+
+// isReviewOf checks whether the timeline event is an actual review, submitted by someone other than the author.
+func isReviewOf(event domain.TimelineEvent, author string) bool {
+	return event.Event == "reviewed" && event.State != "pending" && event.User.Username != author
+}
+
+// addStageDuration adds the time between the provided dates to the respective stage. Any time spent in review after the
+// pull request has been approved is considered as waiting for the pull request to be merged.
+func addStageDuration(stages *domain.CycleTimeStages, stage string, from, to, approvedAt time.Time) {
+	if !to.After(from) {
+		return
+	}
+
+	switch stage {
+	case stageDraft:
+		stages.Draft += to.Sub(from)
+	case stageWaitingForReview:
+		stages.WaitingForReview += to.Sub(from)
+	case stageInReview:
+		if !approvedAt.IsZero() && approvedAt.Before(to) {
+			if approvedAt.After(from) {
+				stages.InReview += approvedAt.Sub(from)
+				from = approvedAt
+			}
+
+			stages.WaitingToMerge += to.Sub(from)
+			return
+		}
+
+		stages.InReview += to.Sub(from)
+	}
+}
@@ -0,0 +1,118 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetCycleTimeStages(t *testing.T) {
+	createdAt := time.Date(2021, 1, 6, 10, 0, 0, 0, time.UTC)
+	at := func(hours float64) time.Time {
+		return createdAt.Add(time.Duration(hours * float64(time.Hour)))
+	}
+
+	testCases := map[string]struct {
+		endAt    time.Time
+		events   []domain.TimelineEvent
+		expected domain.CycleTimeStages
+	}{
+		"Pull request opened as draft and reviewed twice": {
+			endAt: at(8),
+			events: []domain.TimelineEvent{
+				{Event: "merged", CreatedAt: at(8)},
+				{Event: "committed", Committer: domain.Committer{Date: at(-2)}},
+				{Event: "ready_for_review", CreatedAt: at(1)},
+				{Event: "review_requested", CreatedAt: at(1)},
+				{Event: "reviewed", State: "commented", User: domain.User{Username: "author"}, SubmittedAt: at(1.5)},
+				{Event: "reviewed", State: "changes_requested", User: domain.User{Username: "reviewer"}, SubmittedAt: at(3)},
+				{Event: "review_requested", CreatedAt: at(5)},
+				{Event: "reviewed", State: "approved", User: domain.User{Username: "reviewer"}, SubmittedAt: at(6)},
+			},
+			expected: domain.CycleTimeStages{
+				Coding:           2 * time.Hour,
+				Draft:            time.Hour,
+				WaitingForReview: 3 * time.Hour,
+				InReview:         2 * time.Hour,
+				WaitingToMerge:   2 * time.Hour,
+			},
+		},
+		"Pull request converted to draft after a review": {
+			endAt: at(10),
+			events: []domain.TimelineEvent{
+				{Event: "reviewed", State: "changes_requested", User: domain.User{Username: "reviewer"}, SubmittedAt: at(2)},
+				{Event: "convert_to_draft", CreatedAt: at(3)},
+				{Event: "ready_for_review", CreatedAt: at(7)},
+			},
+			expected: domain.CycleTimeStages{
+				Draft:            4 * time.Hour,
+				WaitingForReview: 2 * time.Hour,
+				InReview:         4 * time.Hour,
+				WaitingToMerge:   0,
+			},
+		},
+		"Commented review after an approval": {
+			endAt: at(10),
+			events: []domain.TimelineEvent{
+				{Event: "reviewed", State: "commented", User: domain.User{Username: "reviewer"}, SubmittedAt: at(2)},
+				{Event: "reviewed", State: "approved", User: domain.User{Username: "reviewer"}, SubmittedAt: at(4)},
+				{Event: "reviewed", State: "commented", User: domain.User{Username: "other"}, SubmittedAt: at(6)},
+			},
+			expected: domain.CycleTimeStages{
+				WaitingForReview: 2 * time.Hour,
+				InReview:         2 * time.Hour,
+				WaitingToMerge:   6 * time.Hour,
+			},
+		},
+		"Changes requested after an approval": {
+			endAt: at(10),
+			events: []domain.TimelineEvent{
+				{Event: "reviewed", State: "approved", User: domain.User{Username: "reviewer"}, SubmittedAt: at(2)},
+				{Event: "reviewed", State: "changes_requested", User: domain.User{Username: "other"}, SubmittedAt: at(4)},
+			},
+			expected: domain.CycleTimeStages{
+				WaitingForReview: 2 * time.Hour,
+				InReview:         8 * time.Hour,
+			},
+		},
+		"Open pull request without any review": {
+			endAt:  at(5),
+			events: []domain.TimelineEvent{},
+			expected: domain.CycleTimeStages{
+				WaitingForReview: 5 * time.Hour,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualStages := metrics.GetCycleTimeStages("author", createdAt, tc.endAt, tc.events)
+
+			if !reflect.DeepEqual(tc.expected, actualStages) {
+				t.Errorf("Expected to get '%+v' as stages, but got '%+v'", tc.expected, actualStages)
+			}
+		})
+	}
+}
+
+func TestAverageCycleTimeStages(t *testing.T) {
+	stagesList := []domain.CycleTimeStages{
+		{Coding: time.Hour, InReview: 4 * time.Hour},
+		{Coding: 3 * time.Hour, WaitingToMerge: 2 * time.Hour},
+	}
+
+	expected := domain.CycleTimeStages{
+		Coding:         2 * time.Hour,
+		InReview:       2 * time.Hour,
+		WaitingToMerge: time.Hour,
+	}
+
+	actualAverage := metrics.AverageCycleTimeStages(stagesList)
+
+	if !reflect.DeepEqual(expected, actualAverage) {
+		t.Errorf("Expected to get '%+v' as average, but got '%+v'", expected, actualAverage)
+	}
+}
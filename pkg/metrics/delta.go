@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// ComparePrevious defines the comparison with the period of the same length right before the current one.
+const ComparePrevious = "previous"
+
+// GetComparisonPeriod returns the start and end date of the baseline period to compare the metrics with. The baseline
+// is either the period of the same length right before the provided one, or a custom period in 'start:end' format.
+func GetComparisonPeriod(compareTo string, startDate, endDate time.Time) (time.Time, time.Time, error) {
+	if compareTo == ComparePrevious {
+		numOfDays := int(endDate.Sub(startDate).Hours()/24) + 1
+		baselineStartDate := startDate.AddDate(0, 0, -numOfDays)
+		baselineEndDate := startDate.Add(-time.Second)
+
+		return baselineStartDate, baselineEndDate, nil
+	}
+
+	dates := strings.Split(compareTo, ":")
+	if len(dates) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid comparison period %q, expected 'previous' or 'yyyy-mm-dd:yyyy-mm-dd'", compareTo)
+	}
+
+	baselineStartDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 00:00:00", dates[0]))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse date %q with error : %v", dates[0], err)
+	}
+
+	baselineEndDate, err := time.Parse("2006-01-02 15:04:05", fmt.Sprintf("%v 23:59:59", dates[1]))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse date %q with error : %v", dates[1], err)
+	}
+
+	if baselineEndDate.Before(baselineStartDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid comparison period %q, the end date is before the start date", compareTo)
+	}
+
+	return baselineStartDate, baselineEndDate, nil
+}
+
+// GetDelta calculates the absolute and the percentage change of a value compared to its baseline. In case the baseline
+// is zero, the percentage change is reported as zero.
+func GetDelta(current, baseline float64) domain.MetricDelta {
+	delta := domain.MetricDelta{
+		Current:  roundToTwoDecimals(current),
+		Baseline: roundToTwoDecimals(baseline),
+		Absolute: roundToTwoDecimals(current - baseline),
+	}
+
+	if baseline != 0 {
+		delta.Percentage = roundToTwoDecimals((current - baseline) / math.Abs(baseline) * 100)
+	}
+
+	return delta
+}
+
+// BuildMetricsDelta compares the pull request metrics of the current period with the ones of the baseline period.
+func BuildMetricsDelta(current, baseline domain.PullRequestMetrics, currentFlowRatio, baselineFlowRatio *domain.PullRequestFlowRatio) domain.PullRequestMetricsDelta {
+	return domain.PullRequestMetricsDelta{
+		PullRequests:   GetDelta(float64(len(current.PRDetails)), float64(len(baseline.PRDetails))),
+		Merged:         GetDelta(float64(getMerged(currentFlowRatio)), float64(getMerged(baselineFlowRatio))),
+		AvgLeadTime:    GetDelta(current.Average.LeadTime.Hours(), baseline.Average.LeadTime.Hours()),
+		AvgTimeToMerge: GetDelta(current.Average.TimeToMerge.Hours(), baseline.Average.TimeToMerge.Hours()),
+		AvgSize:        GetDelta(getAverageSize(current.PRDetails), getAverageSize(baseline.PRDetails)),
+		FlowRatio:      GetDelta(getFlowRatio(currentFlowRatio), getFlowRatio(baselineFlowRatio)),
+	}
+}
+
+// getMerged returns the number of merged pull requests of the flow ratio.
+func getMerged(flowRatio *domain.PullRequestFlowRatio) int {
+	if flowRatio == nil {
+		return 0
+	}
+
+	return flowRatio.Merged
+}
+
+// getFlowRatio calculates the ratio of the created to the merged pull requests. In case no pull request has been merged,
+// the number of the created pull requests is returned.
+func getFlowRatio(flowRatio *domain.PullRequestFlowRatio) float64 {
+	if flowRatio == nil {
+		return 0
+	}
+
+	if flowRatio.Merged == 0 {
+		return float64(flowRatio.Created)
+	}
+
+	return float64(flowRatio.Created) / float64(flowRatio.Merged)
+}
+
+// getAverageSize calculates the average number of modified lines of the pull requests.
+func getAverageSize(prDetails []domain.PullRequestMetricDetails) float64 {
+	if len(prDetails) == 0 {
+		return 0
+	}
+
+	totalLines := 0
+	for _, pr := range prDetails {
+		totalLines += pr.SizeLines
+	}
+
+	return float64(totalLines) / float64(len(prDetails))
+}
+
+// roundToTwoDecimals rounds the provided value to two decimal places.
+func roundToTwoDecimals(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,88 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetComparisonPeriod(t *testing.T) {
+	startDate := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 3, 14, 23, 59, 59, 0, time.UTC)
+
+	testCases := map[string]struct {
+		compareTo         string
+		expectedStartDate time.Time
+		expectedEndDate   time.Time
+		expectError       bool
+	}{
+		"Compare with the previous period": {
+			compareTo:         "previous",
+			expectedStartDate: time.Date(2021, 2, 15, 0, 0, 0, 0, time.UTC),
+			expectedEndDate:   time.Date(2021, 2, 28, 23, 59, 59, 0, time.UTC),
+		},
+		"Compare with a custom period": {
+			compareTo:         "2020-03-01:2020-03-14",
+			expectedStartDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectedEndDate:   time.Date(2020, 3, 14, 23, 59, 59, 0, time.UTC),
+		},
+		"Invalid period format - expecting an error": {
+			compareTo:   "last-month",
+			expectError: true,
+		},
+		"End date before start date - expecting an error": {
+			compareTo:   "2020-03-14:2020-03-01",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualStartDate, actualEndDate, actualError := metrics.GetComparisonPeriod(tc.compareTo, startDate, endDate)
+
+			if tc.expectError != (actualError != nil) {
+				t.Errorf("Expected to get an error '%v', but got '%v'", tc.expectError, actualError)
+			}
+			if !tc.expectedStartDate.Equal(actualStartDate) || !tc.expectedEndDate.Equal(actualEndDate) {
+				t.Errorf("Expected to get '%v - %v' as period, but got '%v - %v'", tc.expectedStartDate, tc.expectedEndDate, actualStartDate, actualEndDate)
+			}
+		})
+	}
+}
+
+func TestGetDelta(t *testing.T) {
+	testCases := map[string]struct {
+		current  float64
+		baseline float64
+		expected domain.MetricDelta
+	}{
+		"Value increased": {
+			current:  15,
+			baseline: 10,
+			expected: domain.MetricDelta{Current: 15, Baseline: 10, Absolute: 5, Percentage: 50},
+		},
+		"Value decreased": {
+			current:  2.5,
+			baseline: 10,
+			expected: domain.MetricDelta{Current: 2.5, Baseline: 10, Absolute: -7.5, Percentage: -75},
+		},
+		"Zero baseline": {
+			current:  3,
+			baseline: 0,
+			expected: domain.MetricDelta{Current: 3, Baseline: 0, Absolute: 3, Percentage: 0},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualDelta := metrics.GetDelta(tc.current, tc.baseline)
+
+			if !reflect.DeepEqual(tc.expected, actualDelta) {
+				t.Errorf("Expected to get '%+v' as delta, but got '%+v'", tc.expected, actualDelta)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const rootDirectory = "."
+
+// GetDirectory returns the directory of a file, rolled up to the provided depth. A depth of zero keeps the full
+// directory of the file, while the files placed in the root of the repository are reported under '.'.
+func GetDirectory(filename string, depth int) string {
+	parts := strings.Split(filename, "/")
+	dirs := parts[:len(parts)-1]
+	if len(dirs) == 0 {
+		return rootDirectory
+	}
+
+	if depth > 0 && len(dirs) > depth {
+		dirs = dirs[:depth]
+	}
+
+	return strings.Join(dirs, "/")
+}
+
+// BuildHotspotReport aggregates the files modified by the provided commits in order to rank the most frequently
+// modified files and directories. The number of changes of a file or directory is the number of commits that
+// modified it, while the churn is the sum of the lines added and deleted. Only the top entries of each ranking are
+// kept, unless top is zero.
+func BuildHotspotReport(commits []domain.Commit, depth, top int) domain.HotspotReport {
+	files := make(map[string]*domain.FileHotspot)
+	fileAuthors := make(map[string]map[string]bool)
+	directories := make(map[string]*domain.DirectoryHotspot)
+	directoryAuthors := make(map[string]map[string]bool)
+	directoryFiles := make(map[string]map[string]bool)
+
+	for _, commit := range commits {
+		author := commit.Author.Username
+		if author == "" {
+			author = commit.Details.Committer.Name
+		}
+
+		touchedDirectories := make(map[string]bool)
+		for _, f := range commit.Files {
+			if _, ok := files[f.Filename]; !ok {
+				files[f.Filename] = &domain.FileHotspot{Path: f.Filename}
+				fileAuthors[f.Filename] = make(map[string]bool)
+			}
+
+			files[f.Filename].Changes++
+			files[f.Filename].Additions += f.Additions
+			files[f.Filename].Deletions += f.Deletions
+			files[f.Filename].Churn += f.Additions + f.Deletions
+
+			dir := GetDirectory(f.Filename, depth)
+			if _, ok := directories[dir]; !ok {
+				directories[dir] = &domain.DirectoryHotspot{Path: dir}
+				directoryAuthors[dir] = make(map[string]bool)
+				directoryFiles[dir] = make(map[string]bool)
+			}
+
+			if !touchedDirectories[dir] {
+				directories[dir].Changes++
+				touchedDirectories[dir] = true
+			}
+
+			directories[dir].Additions += f.Additions
+			directories[dir].Deletions += f.Deletions
+			directories[dir].Churn += f.Additions + f.Deletions
+			directoryFiles[dir][f.Filename] = true
+
+			if author != "" {
+				fileAuthors[f.Filename][author] = true
+				directoryAuthors[dir][author] = true
+			}
+		}
+	}
+
+	report := domain.HotspotReport{Commits: len(commits)}
+
+	for path, f := range files {
+		f.Authors = getSortedKeys(fileAuthors[path])
+		report.Files = append(report.Files, *f)
+	}
+
+	for path, d := range directories {
+		d.Files = len(directoryFiles[path])
+		d.Authors = getSortedKeys(directoryAuthors[path])
+		report.Directories = append(report.Directories, *d)
+	}
+
+	sort.Slice(report.Files, func(i, j int) bool {
+		return isHotter(report.Files[i].Changes, report.Files[i].Churn, report.Files[i].Path, report.Files[j].Changes, report.Files[j].Churn, report.Files[j].Path)
+	})
+	sort.Slice(report.Directories, func(i, j int) bool {
+		return isHotter(report.Directories[i].Changes, report.Directories[i].Churn, report.Directories[i].Path, report.Directories[j].Changes, report.Directories[j].Churn, report.Directories[j].Path)
+	})
+
+	if top > 0 && len(report.Files) > top {
+		report.Files = report.Files[:top]
+	}
+
+	if top > 0 && len(report.Directories) > top {
+		report.Directories = report.Directories[:top]
+	}
+
+	return report
+}
+
+// isHotter orders the hotspots by number of changes, then by churn and finally by path.
+func isHotter(changesA, churnA int, pathA string, changesB, churnB int, pathB string) bool {
+	if changesA != changesB {
+		return changesA > changesB
+	}
+
+	if churnA != churnB {
+		return churnA > churnB
+	}
+
+	return pathA < pathB
+}
+
+func getSortedKeys(set map[string]bool) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,90 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetDirectory(t *testing.T) {
+	type args struct {
+		filename string
+		depth    int
+	}
+
+	testCases := map[string]struct {
+		args     args
+		expected string
+	}{
+		"File in root directory": {
+			args:     args{filename: "main.go", depth: 2},
+			expected: ".",
+		},
+		"File in directory shallower than depth": {
+			args:     args{filename: "pkg/main.go", depth: 2},
+			expected: "pkg",
+		},
+		"File in directory deeper than depth": {
+			args:     args{filename: "internal/app/infra/service.go", depth: 2},
+			expected: "internal/app",
+		},
+		"Full directory when depth is zero": {
+			args:     args{filename: "internal/app/infra/service.go", depth: 0},
+			expected: "internal/app/infra",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := metrics.GetDirectory(tc.args.filename, tc.args.depth)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as directory, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBuildHotspotReport(t *testing.T) {
+	commits := []domain.Commit{
+		{
+			Author: domain.User{Username: "alice"},
+			Files: []domain.CommitFile{
+				{Filename: "pkg/a/a.go", Additions: 10, Deletions: 2},
+				{Filename: "pkg/a/b.go", Additions: 1, Deletions: 1},
+			},
+		},
+		{
+			Author: domain.User{Username: "bob"},
+			Files: []domain.CommitFile{
+				{Filename: "pkg/a/a.go", Additions: 3, Deletions: 3},
+				{Filename: "README.md", Additions: 5, Deletions: 0},
+			},
+		},
+		{
+			Details: domain.CommitDetails{Committer: domain.Committer{Name: "carol"}},
+			Files: []domain.CommitFile{
+				{Filename: "README.md", Additions: 1, Deletions: 1},
+			},
+		},
+	}
+
+	expected := domain.HotspotReport{
+		Commits: 3,
+		Files: []domain.FileHotspot{
+			{Path: "pkg/a/a.go", Changes: 2, Additions: 13, Deletions: 5, Churn: 18, Authors: []string{"alice", "bob"}},
+			{Path: "README.md", Changes: 2, Additions: 6, Deletions: 1, Churn: 7, Authors: []string{"bob", "carol"}},
+		},
+		Directories: []domain.DirectoryHotspot{
+			{Path: "pkg", Changes: 2, Files: 2, Additions: 14, Deletions: 6, Churn: 20, Authors: []string{"alice", "bob"}},
+			{Path: ".", Changes: 2, Files: 1, Additions: 6, Deletions: 1, Churn: 7, Authors: []string{"bob", "carol"}},
+		},
+	}
+
+	actual := metrics.BuildHotspotReport(commits, 1, 2)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as hotspot report, but got '%+v'", expected, actual)
+	}
+}
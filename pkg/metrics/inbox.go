@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// The reasons that a pull request waits for an action of a user, from the most urgent to the least urgent one.
+const (
+	InboxReviewRequested  = "Review requested"
+	InboxChangesRequested = "Changes requested"
+	InboxNewCommits       = "New commits since review"
+	InboxNewComments      = "New comments"
+	InboxReadyToMerge     = "Ready to merge"
+)
+
+var inboxUrgency = map[string]int{
+	InboxReviewRequested:  0,
+	InboxChangesRequested: 1,
+	InboxNewCommits:       2,
+	InboxNewComments:      3,
+	InboxReadyToMerge:     4,
+}
+
+// GetInboxReason checks, based on the events of its timeline, whether a pull request waits for an action of the user
+// and returns the reason along with the time that it waits since. The reviews that have been requested from a team of
+// the user do not reveal the user in the timeline, hence the requested flag defines whether the user is known to be a
+// requested reviewer. An empty reason is returned when the pull request does not wait for the user.
+func GetInboxReason(username, author string, requested bool, createdAt time.Time, timelineEvents []domain.TimelineEvent) (string, time.Time) {
+	events := make([]domain.TimelineEvent, len(timelineEvents))
+	copy(events, timelineEvents)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetDate().Before(events[j].GetDate())
+	})
+
+	if username == author {
+		return getAuthorInboxReason(username, events)
+	}
+
+	return getReviewerInboxReason(username, requested, createdAt, events)
+}
+
+// SortInboxItems sorts the inbox items by the urgency of their reason and then by the time they wait since, so that
+// the ones waiting the longest come first.
+func SortInboxItems(items []domain.InboxItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if inboxUrgency[items[i].Reason] != inboxUrgency[items[j].Reason] {
+			return inboxUrgency[items[i].Reason] < inboxUrgency[items[j].Reason]
+		}
+
+		return items[i].WaitingSince.Before(items[j].WaitingSince)
+	})
+}
+
+// getAuthorInboxReason checks whether the pull request of the user has change requests that have not been addressed
+// with a new push, feedback other than approvals that the user has not followed up on or approvals that make it ready
+// to be merged.
+func getAuthorInboxReason(username string, events []domain.TimelineEvent) (string, time.Time) {
+	var lastPushAt, pendingFeedbackAt time.Time
+	latestReviews := map[string]domain.TimelineEvent{}
+	for _, e := range events {
+		user := getEventUser(e)
+		switch {
+		case e.Event == "committed":
+			lastPushAt = e.GetDate()
+			pendingFeedbackAt = time.Time{}
+		case (e.Event == "commented" || e.Event == "reviewed") && user == username:
+			pendingFeedbackAt = time.Time{}
+		case e.Event == "commented" || (e.Event == "reviewed" && !strings.EqualFold(e.State, "pending")):
+			if pendingFeedbackAt.IsZero() && !strings.EqualFold(e.State, "approved") {
+				pendingFeedbackAt = e.GetDate()
+			}
+
+			if strings.EqualFold(e.State, "approved") || strings.EqualFold(e.State, "changes_requested") {
+				latestReviews[user] = e
+			}
+		}
+	}
+
+	var changesRequestedAt, approvedAt time.Time
+	for _, r := range latestReviews {
+		if strings.EqualFold(r.State, "approved") {
+			if r.GetDate().After(approvedAt) {
+				approvedAt = r.GetDate()
+			}
+			continue
+		}
+
+		if r.GetDate().After(lastPushAt) && (changesRequestedAt.IsZero() || r.GetDate().Before(changesRequestedAt)) {
+			changesRequestedAt = r.GetDate()
+		}
+	}
+
+	switch {
+	case !changesRequestedAt.IsZero():
+		return InboxChangesRequested, changesRequestedAt
+	case !pendingFeedbackAt.IsZero():
+		return InboxNewComments, pendingFeedbackAt
+	case !approvedAt.IsZero() && allApproved(latestReviews):
+		return InboxReadyToMerge, approvedAt
+	}
+
+	return "", time.Time{}
+}
+
+// getReviewerInboxReason checks whether the review of the user has been requested and not submitted yet, or whether
+// new commits have been pushed since the last review of the user.
+func getReviewerInboxReason(username string, requested bool, createdAt time.Time, events []domain.TimelineEvent) (string, time.Time) {
+	var requestedAt, anyRequestAt, lastReviewAt, newCommitsAt time.Time
+	for _, e := range events {
+		switch e.Event {
+		case "review_requested":
+			if anyRequestAt.IsZero() {
+				anyRequestAt = e.GetDate()
+			}
+
+			if e.RequestedReviewer.Username == username && requestedAt.IsZero() {
+				requestedAt = e.GetDate()
+			}
+		case "review_request_removed":
+			if e.RequestedReviewer.Username == username {
+				requestedAt = time.Time{}
+			}
+		case "reviewed":
+			if getEventUser(e) == username && !strings.EqualFold(e.State, "pending") {
+				requestedAt, anyRequestAt, newCommitsAt = time.Time{}, time.Time{}, time.Time{}
+				lastReviewAt = e.GetDate()
+			}
+		case "committed":
+			if !lastReviewAt.IsZero() && newCommitsAt.IsZero() {
+				newCommitsAt = e.GetDate()
+			}
+		}
+	}
+
+	switch {
+	case !requestedAt.IsZero():
+		return InboxReviewRequested, requestedAt
+	case requested && !anyRequestAt.IsZero():
+		return InboxReviewRequested, anyRequestAt
+	case requested && lastReviewAt.IsZero():
+		return InboxReviewRequested, createdAt
+	case !newCommitsAt.IsZero():
+		return InboxNewCommits, newCommitsAt
+	}
+
+	return "", time.Time{}
+}
+
+// getEventUser returns the user that triggered a timeline event.
+func getEventUser(event domain.TimelineEvent) string {
+	if event.User.Username != "" {
+		return event.User.Username
+	}
+
+	return event.Actor.Username
+}
+
+// allApproved checks whether the latest review of every reviewer is an approval.
+func allApproved(latestReviews map[string]domain.TimelineEvent) bool {
+	for _, r := range latestReviews {
+		if !strings.EqualFold(r.State, "approved") {
+			return false
+		}
+	}
+
+	return true
+}
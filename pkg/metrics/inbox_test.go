@@ -0,0 +1,124 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetInboxReason(t *testing.T) {
+	baseTime := time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return baseTime.Add(time.Duration(hours) * time.Hour)
+	}
+
+	commit := func(hours int) domain.TimelineEvent {
+		return domain.TimelineEvent{Event: "committed", Committer: domain.Committer{Date: at(hours)}}
+	}
+	review := func(user, state string, hours int) domain.TimelineEvent {
+		return domain.TimelineEvent{Event: "reviewed", User: domain.User{Username: user}, State: state, SubmittedAt: at(hours)}
+	}
+	comment := func(user string, hours int) domain.TimelineEvent {
+		return domain.TimelineEvent{Event: "commented", User: domain.User{Username: user}, Actor: domain.User{Username: user}, CreatedAt: at(hours)}
+	}
+	reviewRequest := func(event, reviewer string, hours int) domain.TimelineEvent {
+		return domain.TimelineEvent{Event: event, RequestedReviewer: domain.User{Username: reviewer}, CreatedAt: at(hours)}
+	}
+
+	type args struct {
+		author    string
+		requested bool
+		events    []domain.TimelineEvent
+	}
+
+	type expected struct {
+		reason string
+		since  time.Time
+	}
+
+	testCases := map[string]struct {
+		args     args
+		expected expected
+	}{
+		"Review requested from the user": {
+			args:     args{author: "bob", events: []domain.TimelineEvent{commit(0), reviewRequest("review_requested", "me", 1), reviewRequest("review_requested", "carol", 2)}},
+			expected: expected{reason: metrics.InboxReviewRequested, since: at(1)},
+		},
+		"Review request removed": {
+			args:     args{author: "bob", events: []domain.TimelineEvent{reviewRequest("review_requested", "me", 1), reviewRequest("review_request_removed", "me", 2)}},
+			expected: expected{reason: "", since: time.Time{}},
+		},
+		"Review requested from a team of the user": {
+			args:     args{author: "bob", requested: true, events: []domain.TimelineEvent{commit(0), reviewRequest("review_requested", "", 3)}},
+			expected: expected{reason: metrics.InboxReviewRequested, since: at(3)},
+		},
+		"New commits since the review of the user": {
+			args:     args{author: "bob", events: []domain.TimelineEvent{reviewRequest("review_requested", "me", 1), review("me", "CHANGES_REQUESTED", 2), commit(4), commit(5)}},
+			expected: expected{reason: metrics.InboxNewCommits, since: at(4)},
+		},
+		"Reviewed without new commits": {
+			args:     args{author: "bob", events: []domain.TimelineEvent{commit(0), review("me", "APPROVED", 2)}},
+			expected: expected{reason: "", since: time.Time{}},
+		},
+		"Changes requested on the pull request of the user": {
+			args:     args{author: "me", events: []domain.TimelineEvent{commit(0), review("bob", "approved", 1), review("carol", "changes_requested", 2), comment("me", 3)}},
+			expected: expected{reason: metrics.InboxChangesRequested, since: at(2)},
+		},
+		"New comments on the pull request of the user": {
+			args:     args{author: "me", events: []domain.TimelineEvent{commit(0), review("carol", "changes_requested", 1), commit(2), comment("bob", 3), comment("carol", 4)}},
+			expected: expected{reason: metrics.InboxNewComments, since: at(3)},
+		},
+		"Pull request of the user ready to merge": {
+			args:     args{author: "me", events: []domain.TimelineEvent{commit(0), review("carol", "changes_requested", 1), commit(2), review("carol", "approved", 3), review("bob", "approved", 4), comment("me", 5)}},
+			expected: expected{reason: metrics.InboxReadyToMerge, since: at(4)},
+		},
+		"Approved pull request of the user without follow up": {
+			args:     args{author: "me", events: []domain.TimelineEvent{commit(0), review("bob", "APPROVED", 2)}},
+			expected: expected{reason: metrics.InboxReadyToMerge, since: at(2)},
+		},
+		"Pull request of the user without feedback": {
+			args:     args{author: "me", events: []domain.TimelineEvent{commit(0), reviewRequest("review_requested", "bob", 1)}},
+			expected: expected{reason: "", since: time.Time{}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			reason, since := metrics.GetInboxReason("me", tc.args.author, tc.args.requested, baseTime, tc.args.events)
+			if reason != tc.expected.reason {
+				t.Errorf("Expected to get '%v' as reason, but got '%v'", tc.expected.reason, reason)
+			}
+
+			if !since.Equal(tc.expected.since) {
+				t.Errorf("Expected to get '%v' as waiting since, but got '%v'", tc.expected.since, since)
+			}
+		})
+	}
+}
+
+func TestSortInboxItems(t *testing.T) {
+	baseTime := time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	items := []domain.InboxItem{
+		{Number: 1, Reason: metrics.InboxReadyToMerge, WaitingSince: baseTime},
+		{Number: 2, Reason: metrics.InboxReviewRequested, WaitingSince: baseTime.Add(time.Hour)},
+		{Number: 3, Reason: metrics.InboxNewComments, WaitingSince: baseTime},
+		{Number: 4, Reason: metrics.InboxReviewRequested, WaitingSince: baseTime},
+	}
+
+	expected := []domain.InboxItem{
+		{Number: 4, Reason: metrics.InboxReviewRequested, WaitingSince: baseTime},
+		{Number: 2, Reason: metrics.InboxReviewRequested, WaitingSince: baseTime.Add(time.Hour)},
+		{Number: 3, Reason: metrics.InboxNewComments, WaitingSince: baseTime},
+		{Number: 1, Reason: metrics.InboxReadyToMerge, WaitingSince: baseTime},
+	}
+
+	metrics.SortInboxItems(items)
+
+	if !reflect.DeepEqual(expected, items) {
+		t.Errorf("Expected to get '%+v' as sorted inbox items, but got '%+v'", expected, items)
+	}
+}
@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"sort"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// GetBusFactor returns the smallest number of contributors whose changes cover more than the provided percentage of
+// the total changes. The changes are expected to be sorted in descending order.
+func GetBusFactor(changes []int, coverage float64) int {
+	total := 0
+	for _, c := range changes {
+		total += c
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	covered := 0
+	for idx, c := range changes {
+		covered += c
+		if float64(covered)/float64(total)*100 > coverage {
+			return idx + 1
+		}
+	}
+
+	return len(changes)
+}
+
+// BuildKnowledgeReport groups the changes of the provided commits per directory and author in order to find the
+// directories that depend on a few people. A change is a commit that modified at least one file of the directory.
+// A directory is flagged as concentrated when its top contributor made more than the threshold percentage of the
+// changes.
+func BuildKnowledgeReport(commits []domain.Commit, depth int, concentrationThreshold, busFactorCoverage float64) domain.KnowledgeReport {
+	directories := make(map[string]*domain.DirectoryKnowledge)
+	contributors := make(map[string]map[string]*domain.KnowledgeContributor)
+
+	for _, commit := range commits {
+		author := commit.Author.Username
+		if author == "" {
+			author = commit.Details.Committer.Name
+		}
+
+		// The commits without an author cannot be attributed to a contributor.
+		if author == "" {
+			continue
+		}
+
+		touchedDirectories := make(map[string]bool)
+		for _, f := range commit.Files {
+			dir := GetDirectory(f.Filename, depth)
+			if _, ok := directories[dir]; !ok {
+				directories[dir] = &domain.DirectoryKnowledge{Path: dir}
+				contributors[dir] = make(map[string]*domain.KnowledgeContributor)
+			}
+
+			if _, ok := contributors[dir][author]; !ok {
+				contributors[dir][author] = &domain.KnowledgeContributor{Author: author}
+			}
+
+			if !touchedDirectories[dir] {
+				directories[dir].Changes++
+				contributors[dir][author].Changes++
+				touchedDirectories[dir] = true
+			}
+
+			directories[dir].Churn += f.Additions + f.Deletions
+			contributors[dir][author].Churn += f.Additions + f.Deletions
+		}
+	}
+
+	report := domain.KnowledgeReport{Commits: len(commits), Directories: []domain.DirectoryKnowledge{}}
+
+	for path, d := range directories {
+		for _, c := range contributors[path] {
+			c.Share = roundToTwoDecimals(float64(c.Changes) / float64(d.Changes) * 100)
+			d.Contributors = append(d.Contributors, *c)
+		}
+
+		sort.Slice(d.Contributors, func(i, j int) bool {
+			if d.Contributors[i].Changes != d.Contributors[j].Changes {
+				return d.Contributors[i].Changes > d.Contributors[j].Changes
+			}
+
+			return d.Contributors[i].Author < d.Contributors[j].Author
+		})
+
+		var changes []int
+		for _, c := range d.Contributors {
+			changes = append(changes, c.Changes)
+		}
+
+		d.TopContributor = d.Contributors[0].Author
+		d.TopContributorShare = d.Contributors[0].Share
+		d.BusFactor = GetBusFactor(changes, busFactorCoverage)
+		d.IsConcentrated = d.TopContributorShare > concentrationThreshold
+
+		if d.IsConcentrated {
+			report.Concentrated++
+		}
+
+		report.Directories = append(report.Directories, *d)
+	}
+
+	sort.Slice(report.Directories, func(i, j int) bool {
+		a, b := report.Directories[i], report.Directories[j]
+		if a.BusFactor != b.BusFactor {
+			return a.BusFactor < b.BusFactor
+		}
+
+		if a.TopContributorShare != b.TopContributorShare {
+			return a.TopContributorShare > b.TopContributorShare
+		}
+
+		if a.Changes != b.Changes {
+			return a.Changes > b.Changes
+		}
+
+		return a.Path < b.Path
+	})
+
+	return report
+}
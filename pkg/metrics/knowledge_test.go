@@ -0,0 +1,97 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetBusFactor(t *testing.T) {
+	type args struct {
+		changes  []int
+		coverage float64
+	}
+
+	testCases := map[string]struct {
+		args     args
+		expected int
+	}{
+		"No changes": {
+			args:     args{changes: []int{}, coverage: 50},
+			expected: 0,
+		},
+		"Single contributor covers everything": {
+			args:     args{changes: []int{10}, coverage: 50},
+			expected: 1,
+		},
+		"Top contributor covers exactly half": {
+			args:     args{changes: []int{5, 3, 2}, coverage: 50},
+			expected: 2,
+		},
+		"Evenly spread changes": {
+			args:     args{changes: []int{1, 1, 1, 1}, coverage: 50},
+			expected: 3,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := metrics.GetBusFactor(tc.args.changes, tc.args.coverage)
+			if actual != tc.expected {
+				t.Errorf("Expected to get '%v' as bus factor, but got '%v'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBuildKnowledgeReport(t *testing.T) {
+	commits := []domain.Commit{
+		{Author: domain.User{Username: "alice"}, Files: []domain.CommitFile{{Filename: "pkg/a.go", Additions: 10}, {Filename: "pkg/b.go", Additions: 5}}},
+		{Author: domain.User{Username: "alice"}, Files: []domain.CommitFile{{Filename: "pkg/a.go", Deletions: 4}}},
+		{Author: domain.User{Username: "alice"}, Files: []domain.CommitFile{{Filename: "pkg/a.go", Additions: 1}}},
+		{Author: domain.User{Username: "bob"}, Files: []domain.CommitFile{{Filename: "pkg/b.go", Additions: 2}, {Filename: "cmd/main.go", Additions: 3}}},
+		{Author: domain.User{Username: "carol"}, Files: []domain.CommitFile{{Filename: "cmd/main.go", Additions: 1}}},
+		{Files: []domain.CommitFile{{Filename: "pkg/a.go", Additions: 7}}},
+	}
+
+	expected := domain.KnowledgeReport{
+		Commits:      6,
+		Concentrated: 1,
+		Directories: []domain.DirectoryKnowledge{
+			{
+				Path:                "pkg",
+				Changes:             4,
+				Churn:               22,
+				TopContributor:      "alice",
+				TopContributorShare: 75,
+				BusFactor:           1,
+				IsConcentrated:      true,
+				Contributors: []domain.KnowledgeContributor{
+					{Author: "alice", Changes: 3, Churn: 20, Share: 75},
+					{Author: "bob", Changes: 1, Churn: 2, Share: 25},
+				},
+			},
+			{
+				Path:                "cmd",
+				Changes:             2,
+				Churn:               4,
+				TopContributor:      "bob",
+				TopContributorShare: 50,
+				BusFactor:           2,
+				IsConcentrated:      false,
+				Contributors: []domain.KnowledgeContributor{
+					{Author: "bob", Changes: 1, Churn: 3, Share: 50},
+					{Author: "carol", Changes: 1, Churn: 1, Share: 50},
+				},
+			},
+		},
+	}
+
+	actual := metrics.BuildKnowledgeReport(commits, 1, 70, 50)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as knowledge report, but got '%+v'", expected, actual)
+	}
+}
@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// UnownedLabel is used to report the pull requests and files that do not have any code owner.
+const UnownedLabel = "(unowned)"
+
+// BuildOwnerBreakdown groups the pull requests per code owner. A pull request that modified files of more than one
+// owner is counted for each one of them. The average lead time is calculated over the merged pull requests.
+func BuildOwnerBreakdown(prDetails []domain.PullRequestMetricDetails) []domain.OwnerBreakdown {
+	owners := make(map[string]*domain.OwnerBreakdown)
+	totalLeadTime := make(map[string]time.Duration)
+
+	for _, pr := range prDetails {
+		prOwners := pr.Owners
+		if len(prOwners) == 0 {
+			prOwners = []string{UnownedLabel}
+		}
+
+		for _, owner := range prOwners {
+			if _, ok := owners[owner]; !ok {
+				owners[owner] = &domain.OwnerBreakdown{Owner: owner}
+			}
+
+			owners[owner].PullRequests++
+			owners[owner].Lines += pr.SizeLines
+
+			if pr.LeadTime > 0 {
+				owners[owner].Merged++
+				totalLeadTime[owner] += pr.LeadTime
+			}
+		}
+	}
+
+	breakdown := []domain.OwnerBreakdown{}
+	for owner, o := range owners {
+		if o.Merged > 0 {
+			o.AvgLeadTime = time.Duration(totalLeadTime[owner].Seconds()/float64(o.Merged)) * time.Second
+		}
+
+		breakdown = append(breakdown, *o)
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].PullRequests != breakdown[j].PullRequests {
+			return breakdown[i].PullRequests > breakdown[j].PullRequests
+		}
+
+		return breakdown[i].Owner < breakdown[j].Owner
+	})
+
+	return breakdown
+}
+
+// BuildOwnerHotspots aggregates the files modified by the provided commits per code owner, using the provided
+// function to resolve the owners of each file. Only the top entries are kept, unless top is zero.
+func BuildOwnerHotspots(commits []domain.Commit, getFileOwners func(filename string) []string, top int) []domain.OwnerHotspot {
+	owners := make(map[string]*domain.OwnerHotspot)
+	ownerAuthors := make(map[string]map[string]bool)
+	ownerFiles := make(map[string]map[string]bool)
+
+	for _, commit := range commits {
+		author := commit.Author.Username
+		if author == "" {
+			author = commit.Details.Committer.Name
+		}
+
+		touchedOwners := make(map[string]bool)
+		for _, f := range commit.Files {
+			fileOwners := getFileOwners(f.Filename)
+			if len(fileOwners) == 0 {
+				fileOwners = []string{UnownedLabel}
+			}
+
+			for _, owner := range fileOwners {
+				if _, ok := owners[owner]; !ok {
+					owners[owner] = &domain.OwnerHotspot{Owner: owner}
+					ownerAuthors[owner] = make(map[string]bool)
+					ownerFiles[owner] = make(map[string]bool)
+				}
+
+				if !touchedOwners[owner] {
+					owners[owner].Changes++
+					touchedOwners[owner] = true
+				}
+
+				owners[owner].Additions += f.Additions
+				owners[owner].Deletions += f.Deletions
+				owners[owner].Churn += f.Additions + f.Deletions
+				ownerFiles[owner][f.Filename] = true
+
+				if author != "" {
+					ownerAuthors[owner][author] = true
+				}
+			}
+		}
+	}
+
+	hotspots := []domain.OwnerHotspot{}
+	for owner, o := range owners {
+		o.Files = len(ownerFiles[owner])
+		o.Authors = getSortedKeys(ownerAuthors[owner])
+		hotspots = append(hotspots, *o)
+	}
+
+	sort.Slice(hotspots, func(i, j int) bool {
+		return isHotter(hotspots[i].Changes, hotspots[i].Churn, hotspots[i].Owner, hotspots[j].Changes, hotspots[j].Churn, hotspots[j].Owner)
+	})
+
+	if top > 0 && len(hotspots) > top {
+		hotspots = hotspots[:top]
+	}
+
+	return hotspots
+}
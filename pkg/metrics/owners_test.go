@@ -0,0 +1,69 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestBuildOwnerBreakdown(t *testing.T) {
+	prDetails := []domain.PullRequestMetricDetails{
+		{Owners: []string{"@org/core", "@alice"}, SizeLines: 10, LeadTime: 2 * time.Hour},
+		{Owners: []string{"@org/core"}, SizeLines: 20, LeadTime: 4 * time.Hour},
+		{Owners: []string{"@org/core"}, SizeLines: 5},
+		{SizeLines: 1, LeadTime: time.Hour},
+	}
+
+	expected := []domain.OwnerBreakdown{
+		{Owner: "@org/core", PullRequests: 3, Merged: 2, Lines: 35, AvgLeadTime: 3 * time.Hour},
+		{Owner: "(unowned)", PullRequests: 1, Merged: 1, Lines: 1, AvgLeadTime: time.Hour},
+		{Owner: "@alice", PullRequests: 1, Merged: 1, Lines: 10, AvgLeadTime: 2 * time.Hour},
+	}
+
+	actual := metrics.BuildOwnerBreakdown(prDetails)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as owner breakdown, but got '%+v'", expected, actual)
+	}
+}
+
+func TestBuildOwnerHotspots(t *testing.T) {
+	commits := []domain.Commit{
+		{
+			Author: domain.User{Username: "alice"},
+			Files: []domain.CommitFile{
+				{Filename: "pkg/a.go", Additions: 10, Deletions: 2},
+				{Filename: "pkg/b.go", Additions: 1, Deletions: 1},
+			},
+		},
+		{
+			Author: domain.User{Username: "bob"},
+			Files: []domain.CommitFile{
+				{Filename: "pkg/a.go", Additions: 3, Deletions: 3},
+				{Filename: "README.md", Additions: 5, Deletions: 0},
+			},
+		},
+	}
+
+	getFileOwners := func(filename string) []string {
+		if filename == "README.md" {
+			return []string{}
+		}
+
+		return []string{"@org/core"}
+	}
+
+	expected := []domain.OwnerHotspot{
+		{Owner: "@org/core", Changes: 2, Files: 2, Additions: 14, Deletions: 6, Churn: 20, Authors: []string{"alice", "bob"}},
+		{Owner: "(unowned)", Changes: 1, Files: 1, Additions: 5, Deletions: 0, Churn: 5, Authors: []string{"bob"}},
+	}
+
+	actual := metrics.BuildOwnerHotspots(commits, getFileOwners, 0)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as owner hotspots, but got '%+v'", expected, actual)
+	}
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// BuildRepositoryBreakdown aggregates the pull requests per repository, for the metrics that span multiple
+// repositories. The repositories are sorted by their number of pull requests.
+func BuildRepositoryBreakdown(prDetails []domain.PullRequestMetricDetails) []domain.RepositoryBreakdown {
+	repositories := make(map[string]*domain.RepositoryBreakdown)
+	totalLeadTime := make(map[string]time.Duration)
+	totalTimeToMerge := make(map[string]time.Duration)
+
+	for _, pr := range prDetails {
+		if _, ok := repositories[pr.Repository]; !ok {
+			repositories[pr.Repository] = &domain.RepositoryBreakdown{Repository: pr.Repository}
+		}
+
+		repositories[pr.Repository].PullRequests++
+		repositories[pr.Repository].Lines += pr.SizeLines
+
+		if pr.LeadTime > 0 {
+			repositories[pr.Repository].Merged++
+			totalLeadTime[pr.Repository] += pr.LeadTime
+			totalTimeToMerge[pr.Repository] += pr.TimeToMerge
+		}
+	}
+
+	breakdown := []domain.RepositoryBreakdown{}
+	for repository, r := range repositories {
+		if r.Merged > 0 {
+			r.AvgLeadTime = time.Duration(totalLeadTime[repository].Seconds()/float64(r.Merged)) * time.Second
+			r.AvgTimeToMerge = time.Duration(totalTimeToMerge[repository].Seconds()/float64(r.Merged)) * time.Second
+		}
+
+		breakdown = append(breakdown, *r)
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].PullRequests != breakdown[j].PullRequests {
+			return breakdown[i].PullRequests > breakdown[j].PullRequests
+		}
+
+		return breakdown[i].Repository < breakdown[j].Repository
+	})
+
+	return breakdown
+}
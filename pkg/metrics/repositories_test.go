@@ -0,0 +1,32 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestBuildRepositoryBreakdown(t *testing.T) {
+	prDetails := []domain.PullRequestMetricDetails{
+		{Repository: "org/web", SizeLines: 10, LeadTime: 2 * time.Hour, TimeToMerge: time.Hour},
+		{Repository: "org/api", SizeLines: 20, LeadTime: 4 * time.Hour, TimeToMerge: 2 * time.Hour},
+		{Repository: "org/api", SizeLines: 5},
+		{Repository: "org/api", SizeLines: 1, LeadTime: 2 * time.Hour, TimeToMerge: 4 * time.Hour},
+		{Repository: "org/lib", SizeLines: 3, LeadTime: time.Hour, TimeToMerge: time.Hour},
+	}
+
+	expected := []domain.RepositoryBreakdown{
+		{Repository: "org/api", PullRequests: 3, Merged: 2, Lines: 26, AvgLeadTime: 3 * time.Hour, AvgTimeToMerge: 3 * time.Hour},
+		{Repository: "org/lib", PullRequests: 1, Merged: 1, Lines: 3, AvgLeadTime: time.Hour, AvgTimeToMerge: time.Hour},
+		{Repository: "org/web", PullRequests: 1, Merged: 1, Lines: 10, AvgLeadTime: 2 * time.Hour, AvgTimeToMerge: time.Hour},
+	}
+
+	actual := metrics.BuildRepositoryBreakdown(prDetails)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as repository breakdown, but got '%+v'", expected, actual)
+	}
+}
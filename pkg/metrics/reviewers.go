@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"sort"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// openReviewStates lists the review states of a reviewer that keep a pull request waiting for them.
+var openReviewStates = map[string]bool{
+	"PENDING":           true,
+	"COMMENTED":         true,
+	"CHANGES_REQUESTED": true,
+}
+
+// GetOpenReviewLoad returns the number of open pull requests that are waiting for each reviewer, which are the ones
+// that the reviewer has been requested for or has not approved yet. The pull request with the excluded number is
+// not taken into account.
+func GetOpenReviewLoad(pullRequests []domain.PullRequest, excludedNumber int) map[string]int {
+	reviewLoad := make(map[string]int)
+	for _, pr := range pullRequests {
+		if pr.Number == excludedNumber {
+			continue
+		}
+
+		for reviewer, state := range pr.ReviewStates {
+			if reviewer != pr.Creator.Username && openReviewStates[state] {
+				reviewLoad[reviewer]++
+			}
+		}
+	}
+
+	return reviewLoad
+}
+
+// RankReviewerCandidates scores the candidate reviewers of a pull request and sorts them by their score. The score
+// adds up the weighted shares of the modified files that a candidate owns and has recently modified and the team
+// weight for the members of the team, while each open review of the candidate deducts the review load weight.
+func RankReviewerCandidates(candidates []domain.ReviewerCandidate, files int, weights domain.ReviewerWeights) []domain.ReviewerCandidate {
+	ranked := make([]domain.ReviewerCandidate, len(candidates))
+	copy(ranked, candidates)
+
+	for idx := range ranked {
+		score := 0.0
+		if files > 0 {
+			score += weights.Ownership * float64(ranked[idx].OwnedFiles) / float64(files)
+			score += weights.Authorship * float64(ranked[idx].AuthoredFiles) / float64(files)
+		}
+
+		if ranked[idx].IsTeamMember {
+			score += weights.Team
+		}
+
+		score -= weights.ReviewLoad * float64(ranked[idx].OpenReviews)
+
+		ranked[idx].Score = roundToTwoDecimals(score)
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+
+		return ranked[i].Username < ranked[j].Username
+	})
+
+	return ranked
+}
@@ -0,0 +1,83 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetOpenReviewLoad(t *testing.T) {
+	pullRequests := []domain.PullRequest{
+		{Number: 1, Creator: domain.User{Username: "alice"}, ReviewStates: map[string]string{"bob": "PENDING", "carol": "APPROVED"}},
+		{Number: 2, Creator: domain.User{Username: "bob"}, ReviewStates: map[string]string{"alice": "CHANGES_REQUESTED", "carol": "COMMENTED", "bob": "COMMENTED"}},
+		{Number: 3, Creator: domain.User{Username: "carol"}, ReviewStates: map[string]string{"bob": "PENDING", "alice": "DISMISSED"}},
+	}
+
+	expected := map[string]int{"bob": 2}
+
+	actual := metrics.GetOpenReviewLoad(pullRequests, 2)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%v' as open review load, but got '%v'", expected, actual)
+	}
+}
+
+func TestRankReviewerCandidates(t *testing.T) {
+	weights := domain.ReviewerWeights{Ownership: 3, Authorship: 2, Team: 1, ReviewLoad: 0.5}
+
+	type args struct {
+		candidates []domain.ReviewerCandidate
+		files      int
+	}
+
+	testCases := map[string]struct {
+		args     args
+		expected []domain.ReviewerCandidate
+	}{
+		"No candidates": {
+			args:     args{candidates: []domain.ReviewerCandidate{}, files: 3},
+			expected: []domain.ReviewerCandidate{},
+		},
+		"Candidates ranked by their score": {
+			args: args{
+				candidates: []domain.ReviewerCandidate{
+					{Username: "dave", IsTeamMember: true},
+					{Username: "carol", AuthoredFiles: 2, RecentCommits: 5, OpenReviews: 2},
+					{Username: "bob", OwnedFiles: 3, OpenReviews: 4},
+					{Username: "alice", OwnedFiles: 3, AuthoredFiles: 1, RecentCommits: 1, IsTeamMember: true},
+				},
+				files: 4,
+			},
+			expected: []domain.ReviewerCandidate{
+				{Username: "alice", OwnedFiles: 3, AuthoredFiles: 1, RecentCommits: 1, IsTeamMember: true, Score: 3.75},
+				{Username: "dave", IsTeamMember: true, Score: 1},
+				{Username: "bob", OwnedFiles: 3, OpenReviews: 4, Score: 0.25},
+				{Username: "carol", AuthoredFiles: 2, RecentCommits: 5, OpenReviews: 2, Score: 0},
+			},
+		},
+		"Candidates with equal score ranked by username": {
+			args: args{
+				candidates: []domain.ReviewerCandidate{
+					{Username: "bob", OwnedFiles: 1},
+					{Username: "alice", OwnedFiles: 1},
+				},
+				files: 0,
+			},
+			expected: []domain.ReviewerCandidate{
+				{Username: "alice", OwnedFiles: 1, Score: 0},
+				{Username: "bob", OwnedFiles: 1, Score: 0},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := metrics.RankReviewerCandidates(tc.args.candidates, tc.args.files, weights)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("Expected to get '%+v' as ranked candidates, but got '%+v'", tc.expected, actual)
+			}
+		})
+	}
+}
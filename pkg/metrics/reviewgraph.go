@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"sort"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+// BuildReviewGraph builds the weighted graph from the authors of the pull requests to their reviewers. The weight of
+// an edge is the number of pull requests of the author that the reviewer reviewed. Self reviews are ignored.
+func BuildReviewGraph(pullRequests []domain.PullRequestReviewers) domain.ReviewGraph {
+	graph := domain.ReviewGraph{
+		Edges:     []domain.ReviewEdge{},
+		Adjacency: make(map[string]map[string]int),
+	}
+
+	authors := make(map[string]map[string]bool)
+	reviewers := make(map[string]map[string]bool)
+
+	for _, pr := range pullRequests {
+		graph.Stats.PullRequests++
+
+		if _, ok := authors[pr.Author]; !ok {
+			authors[pr.Author] = make(map[string]bool)
+		}
+
+		reviewed := false
+		for _, reviewer := range pr.Reviewers {
+			if reviewer == pr.Author {
+				continue
+			}
+
+			if _, ok := graph.Adjacency[pr.Author]; !ok {
+				graph.Adjacency[pr.Author] = make(map[string]int)
+			}
+
+			if _, ok := reviewers[reviewer]; !ok {
+				reviewers[reviewer] = make(map[string]bool)
+			}
+
+			graph.Adjacency[pr.Author][reviewer]++
+			authors[pr.Author][reviewer] = true
+			reviewers[reviewer][pr.Author] = true
+			reviewed = true
+		}
+
+		if !reviewed {
+			graph.Stats.Unreviewed++
+		}
+	}
+
+	reciprocal := 0
+	for author, adjacentReviewers := range graph.Adjacency {
+		for reviewer, weight := range adjacentReviewers {
+			graph.Edges = append(graph.Edges, domain.ReviewEdge{Author: author, Reviewer: reviewer, Weight: weight})
+
+			if _, ok := graph.Adjacency[reviewer][author]; ok {
+				reciprocal++
+			}
+		}
+	}
+
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Weight != graph.Edges[j].Weight {
+			return graph.Edges[i].Weight > graph.Edges[j].Weight
+		}
+
+		if graph.Edges[i].Author != graph.Edges[j].Author {
+			return graph.Edges[i].Author < graph.Edges[j].Author
+		}
+
+		return graph.Edges[i].Reviewer < graph.Edges[j].Reviewer
+	})
+
+	graph.Stats.Authors = len(authors)
+	graph.Stats.Reviewers = len(reviewers)
+	graph.Stats.Edges = len(graph.Edges)
+	if len(graph.Edges) > 0 {
+		graph.Stats.Reciprocity = roundToTwoDecimals(float64(reciprocal) / float64(len(graph.Edges)) * 100)
+	}
+
+	graph.Stats.SingleAuthorReviewers = []string{}
+	for reviewer, reviewedAuthors := range reviewers {
+		if len(reviewedAuthors) == 1 {
+			graph.Stats.SingleAuthorReviewers = append(graph.Stats.SingleAuthorReviewers, reviewer)
+		}
+	}
+	sort.Strings(graph.Stats.SingleAuthorReviewers)
+
+	graph.Stats.SingleReviewerAuthors = []string{}
+	for author, authorReviewers := range authors {
+		if len(authorReviewers) == 1 {
+			graph.Stats.SingleReviewerAuthors = append(graph.Stats.SingleReviewerAuthors, author)
+		}
+	}
+	sort.Strings(graph.Stats.SingleReviewerAuthors)
+
+	return graph
+}
@@ -0,0 +1,49 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestBuildReviewGraph(t *testing.T) {
+	pullRequests := []domain.PullRequestReviewers{
+		{Author: "alice", Reviewers: []string{"bob", "carol"}},
+		{Author: "alice", Reviewers: []string{"bob", "alice"}},
+		{Author: "bob", Reviewers: []string{"alice"}},
+		{Author: "dave", Reviewers: []string{"bob"}},
+		{Author: "erin", Reviewers: []string{}},
+	}
+
+	expected := domain.ReviewGraph{
+		Edges: []domain.ReviewEdge{
+			{Author: "alice", Reviewer: "bob", Weight: 2},
+			{Author: "alice", Reviewer: "carol", Weight: 1},
+			{Author: "bob", Reviewer: "alice", Weight: 1},
+			{Author: "dave", Reviewer: "bob", Weight: 1},
+		},
+		Adjacency: map[string]map[string]int{
+			"alice": {"bob": 2, "carol": 1},
+			"bob":   {"alice": 1},
+			"dave":  {"bob": 1},
+		},
+		Stats: domain.ReviewGraphStats{
+			PullRequests:          5,
+			Unreviewed:            1,
+			Authors:               4,
+			Reviewers:             3,
+			Edges:                 4,
+			Reciprocity:           50,
+			SingleAuthorReviewers: []string{"alice", "carol"},
+			SingleReviewerAuthors: []string{"bob", "dave"},
+		},
+	}
+
+	actual := metrics.BuildReviewGraph(pullRequests)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected to get '%+v' as review graph, but got '%+v'", expected, actual)
+	}
+}
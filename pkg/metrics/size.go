@@ -0,0 +1,177 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+)
+
+const (
+	// PeriodDay groups the data per day.
+	PeriodDay = "day"
+	// PeriodWeek groups the data per ISO week.
+	PeriodWeek = "week"
+	// PeriodMonth groups the data per month.
+	PeriodMonth = "month"
+)
+
+// SizeLabels describes the labels of the pull request sizes in ascending order.
+var SizeLabels = []string{"XS", "S", "M", "L", "XL"}
+
+// ValidateSizeThresholds checks that there is one threshold for each size label apart from the last one, in ascending
+// order, so that each label maps to a threshold.
+func ValidateSizeThresholds(thresholds []int) error {
+	if len(thresholds) != len(SizeLabels)-1 {
+		return fmt.Errorf("expected %d pull request size thresholds for the %v sizes, but got %d", len(SizeLabels)-1, SizeLabels[:len(SizeLabels)-1], len(thresholds))
+	}
+
+	for idx := 1; idx < len(thresholds); idx++ {
+		if thresholds[idx] <= thresholds[idx-1] {
+			return fmt.Errorf("expected the pull request size thresholds to be in ascending order, but got %v", thresholds)
+		}
+	}
+
+	return nil
+}
+
+// GetSizeLabel returns the size label of a pull request based on the number of lines modified and the maximum number
+// of lines allowed for each size.
+func GetSizeLabel(lines int, thresholds []int) string {
+	for idx, maxLines := range thresholds {
+		if idx >= len(SizeLabels)-1 {
+			break
+		}
+
+		if lines <= maxLines {
+			return SizeLabels[idx]
+		}
+	}
+
+	return SizeLabels[len(SizeLabels)-1]
+}
+
+// GetSizeLines returns the number of lines modified in the provided files, leaving out the ones that are excluded.
+func GetSizeLines(files []domain.CommitFile, isExcluded func(filename string) bool) int {
+	sizeLines := 0
+	for _, f := range files {
+		if !isExcluded(f.Filename) {
+			sizeLines += f.Additions + f.Deletions
+		}
+	}
+
+	return sizeLines
+}
+
+// GetPeriodKey returns the key of the period that the provided date belongs to.
+func GetPeriodKey(date time.Time, period string) string {
+	switch period {
+	case PeriodDay:
+		return date.Format("2006-01-02")
+	case PeriodMonth:
+		return date.Format("2006-01")
+	default:
+		year, week := date.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	}
+}
+
+// Correlation calculates the pearson correlation coefficient of two data sets of the same length.
+func Correlation(xs, ys []float64) float64 {
+	n := len(xs)
+	if n < 2 || n != len(ys) {
+		return 0
+	}
+
+	var sumX, sumY float64
+	for i := 0; i < n; i++ {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/float64(n), sumY/float64(n)
+
+	var covariance, varianceX, varianceY float64
+	for i := 0; i < n; i++ {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+
+	if varianceX == 0 || varianceY == 0 {
+		return 0
+	}
+
+	return roundToTwoDecimals(covariance / math.Sqrt(varianceX*varianceY))
+}
+
+// BuildSizeReport prepares the size distribution of the provided pull requests, overall and per period, as well as
+// the correlation of their size with their lead time and the top oversized ones.
+func BuildSizeReport(prDetails []domain.PullRequestMetricDetails, period string, topOversized int) domain.PullRequestSizeReport {
+	sizeReport := domain.PullRequestSizeReport{}
+
+	bucketIndex := make(map[string]int)
+	mergedPerBucket := make(map[string]int)
+	for idx, label := range SizeLabels {
+		sizeReport.Buckets = append(sizeReport.Buckets, domain.PullRequestSizeBucket{Size: label})
+		bucketIndex[label] = idx
+	}
+
+	periods := make(map[string]map[string]int)
+	var sizes, leadTimes []float64
+	var oversized []domain.PullRequestMetricDetails
+
+	for _, pr := range prDetails {
+		bucket := &sizeReport.Buckets[bucketIndex[pr.Size]]
+		bucket.Count++
+		bucket.Lines += pr.SizeLines
+
+		if pr.LeadTime > 0 {
+			bucket.AvgLeadTime += pr.LeadTime
+			mergedPerBucket[pr.Size]++
+
+			sizes = append(sizes, float64(pr.SizeLines))
+			leadTimes = append(leadTimes, pr.LeadTime.Hours())
+		}
+
+		periodKey := GetPeriodKey(pr.CreatedAt, period)
+		if _, ok := periods[periodKey]; !ok {
+			periods[periodKey] = make(map[string]int)
+		}
+		periods[periodKey][pr.Size]++
+
+		if pr.Size == SizeLabels[len(SizeLabels)-1] {
+			oversized = append(oversized, pr)
+		}
+	}
+
+	for idx := range sizeReport.Buckets {
+		if merged := mergedPerBucket[sizeReport.Buckets[idx].Size]; merged > 0 {
+			sizeReport.Buckets[idx].AvgLeadTime = time.Duration(sizeReport.Buckets[idx].AvgLeadTime.Seconds()/float64(merged)) * time.Second
+		}
+	}
+
+	periodKeys := make([]string, 0, len(periods))
+	for k := range periods {
+		periodKeys = append(periodKeys, k)
+	}
+	sort.Strings(periodKeys)
+
+	for _, k := range periodKeys {
+		sizeReport.Periods = append(sizeReport.Periods, domain.PullRequestSizePeriod{Period: k, Sizes: periods[k]})
+	}
+
+	sort.Slice(oversized, func(i, j int) bool {
+		return oversized[i].SizeLines > oversized[j].SizeLines
+	})
+	if topOversized > 0 && len(oversized) > topOversized {
+		oversized = oversized[:topOversized]
+	}
+
+	sizeReport.Oversized = oversized
+	sizeReport.LeadTimeCorrelation = Correlation(sizes, leadTimes)
+
+	return sizeReport
+}
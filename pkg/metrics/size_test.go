@@ -0,0 +1,158 @@
+package metrics_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/eujoy/gitpr/pkg/metrics"
+)
+
+func TestGetSizeLabel(t *testing.T) {
+	thresholds := []int{10, 100, 500, 1000}
+
+	testCases := map[string]struct {
+		lines    int
+		expected string
+	}{
+		"No lines modified":               {0, "XS"},
+		"Lines equal to a threshold":      {100, "S"},
+		"Lines between two thresholds":    {250, "M"},
+		"Lines just below the last limit": {1000, "L"},
+		"Lines above the last threshold":  {1001, "XL"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualLabel := metrics.GetSizeLabel(tc.lines, thresholds)
+
+			if tc.expected != actualLabel {
+				t.Errorf("Expected to get '%v' as size, but got '%v'", tc.expected, actualLabel)
+			}
+		})
+	}
+}
+
+func TestGetSizeLines(t *testing.T) {
+	files := []domain.CommitFile{
+		{Filename: "cmd/main.go", Additions: 10, Deletions: 5},
+		{Filename: "vendor/lib/lib.go", Additions: 500, Deletions: 0},
+		{Filename: "internal/service.go", Additions: 20, Deletions: 15},
+	}
+
+	isExcluded := func(filename string) bool {
+		return filename == "vendor/lib/lib.go"
+	}
+
+	expected := 50
+	if actual := metrics.GetSizeLines(files, isExcluded); expected != actual {
+		t.Errorf("Expected to get '%v' as size lines, but got '%v'", expected, actual)
+	}
+}
+
+func TestValidateSizeThresholds(t *testing.T) {
+	testCases := map[string]struct {
+		thresholds []int
+		isError    bool
+	}{
+		"One threshold for each size apart from the last one": {[]int{10, 100, 500, 1000}, false},
+		"Fewer thresholds than sizes":                         {[]int{10, 100}, true},
+		"More thresholds than sizes":                          {[]int{10, 100, 500, 1000, 5000}, true},
+		"No thresholds":                                       {nil, true},
+		"Thresholds not in ascending order":                   {[]int{10, 500, 100, 1000}, true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualError := metrics.ValidateSizeThresholds(tc.thresholds)
+
+			if tc.isError != (actualError != nil) {
+				t.Errorf("Expected to get an error '%v', but got '%v'", tc.isError, actualError)
+			}
+		})
+	}
+}
+
+func TestGetPeriodKey(t *testing.T) {
+	date := time.Date(2021, 1, 6, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		period   string
+		expected string
+	}{
+		"Group per day":                    {metrics.PeriodDay, "2021-01-06"},
+		"Group per week":                   {metrics.PeriodWeek, "2021-W01"},
+		"Group per month":                  {metrics.PeriodMonth, "2021-01"},
+		"Unknown period defaults to weeks": {"unknown", "2021-W01"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualKey := metrics.GetPeriodKey(date, tc.period)
+
+			if tc.expected != actualKey {
+				t.Errorf("Expected to get '%v' as period, but got '%v'", tc.expected, actualKey)
+			}
+		})
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	testCases := map[string]struct {
+		xs       []float64
+		ys       []float64
+		expected float64
+	}{
+		"Perfect positive correlation": {[]float64{1, 2, 3}, []float64{2, 4, 6}, 1},
+		"Perfect negative correlation": {[]float64{1, 2, 3}, []float64{6, 4, 2}, -1},
+		"Not enough data":              {[]float64{1}, []float64{2}, 0},
+		"No variance in the data":      {[]float64{1, 1, 1}, []float64{2, 4, 6}, 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualCorrelation := metrics.Correlation(tc.xs, tc.ys)
+
+			if tc.expected != actualCorrelation {
+				t.Errorf("Expected to get '%v' as correlation, but got '%v'", tc.expected, actualCorrelation)
+			}
+		})
+	}
+}
+
+func TestBuildSizeReport(t *testing.T) {
+	createdAt := time.Date(2021, 1, 6, 10, 0, 0, 0, time.UTC)
+	prDetails := []domain.PullRequestMetricDetails{
+		{Number: 1, Size: "XS", SizeLines: 5, LeadTime: time.Hour, CreatedAt: createdAt},
+		{Number: 2, Size: "XS", SizeLines: 3, LeadTime: 3 * time.Hour, CreatedAt: createdAt},
+		{Number: 3, Size: "XL", SizeLines: 2000, CreatedAt: createdAt},
+		{Number: 4, Size: "XL", SizeLines: 5000, LeadTime: 48 * time.Hour, CreatedAt: createdAt.AddDate(0, 1, 0)},
+	}
+
+	actualReport := metrics.BuildSizeReport(prDetails, metrics.PeriodMonth, 1)
+
+	if actualReport.Buckets[0].Count != 2 || actualReport.Buckets[0].Lines != 8 || actualReport.Buckets[0].AvgLeadTime != 2*time.Hour {
+		t.Errorf("Unexpected details for the XS bucket : %+v", actualReport.Buckets[0])
+	}
+
+	if actualReport.Buckets[4].Count != 2 || actualReport.Buckets[4].AvgLeadTime != 48*time.Hour {
+		t.Errorf("Unexpected details for the XL bucket : %+v", actualReport.Buckets[4])
+	}
+
+	expectedPeriods := []domain.PullRequestSizePeriod{
+		{Period: "2021-01", Sizes: map[string]int{"XS": 2, "XL": 1}},
+		{Period: "2021-02", Sizes: map[string]int{"XL": 1}},
+	}
+	if !reflect.DeepEqual(expectedPeriods, actualReport.Periods) {
+		t.Errorf("Expected to get '%v' as periods, but got '%v'", expectedPeriods, actualReport.Periods)
+	}
+
+	if len(actualReport.Oversized) != 1 || actualReport.Oversized[0].Number != 4 {
+		t.Errorf("Expected to get pull request #4 as the top oversized, but got '%v'", actualReport.Oversized)
+	}
+
+	if actualReport.LeadTimeCorrelation <= 0 {
+		t.Errorf("Expected to get a positive correlation, but got '%v'", actualReport.LeadTimeCorrelation)
+	}
+}
@@ -4,9 +4,12 @@ import (
     "fmt"
     "os"
     "sort"
+    "strings"
+    "time"
 
     "github.com/eujoy/gitpr/internal/domain"
     "github.com/jedib0t/go-pretty/v6/table"
+    "github.com/jedib0t/go-pretty/v6/text"
 )
 
 // TablePrinter wraps the printout for models as table.
@@ -105,7 +108,12 @@ func (t *TablePrinter) PrintPullRequestMetrics(pullRequests domain.PullRequestMe
     outputTable.AppendHeader(table.Row{"#", "Title", "Comments", "Review Comments", "Commits", "Additions", "Deletions", "Changed Files", "Lead Time", "Time to Merge", "Created At"})
 
     for _, p := range pullRequests.PRDetails {
-        outputTable.AppendRow(table.Row{p.Number, p.Title, p.Comments, p.ReviewComments, p.Commits, p.Additions, p.Deletions, p.ChangedFiles, p.StrLeadTime, p.StrTimeToMerge, p.CreatedAt})
+        var number interface{} = p.Number
+        if p.Repository != "" {
+            number = fmt.Sprintf("%v#%v", p.Repository, p.Number)
+        }
+
+        outputTable.AppendRow(table.Row{number, p.Title, p.Comments, p.ReviewComments, p.Commits, p.Additions, p.Deletions, p.ChangedFiles, p.StrLeadTime, p.StrTimeToMerge, p.CreatedAt})
     }
 
     totalRow, averageRow := t.getTotalAndAverageRows(pullRequests.Total, pullRequests.Average)
@@ -118,6 +126,57 @@ func (t *TablePrinter) PrintPullRequestMetrics(pullRequests domain.PullRequestMe
     outputTable.Render()
 }
 
+// PrintReleases prints the releases of a repository as table.
+func (t *TablePrinter) PrintReleases(releases []domain.Release) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"ID", "Tag", "Name", "Status", "Created At", "Published At", "Url"})
+
+    for _, r := range releases {
+        status, publishedAt := "Published", ""
+        switch {
+        case r.Draft:
+            status = "Draft"
+        case r.PreRelease:
+            status = "Pre-release"
+        }
+
+        if !r.PublishedAt.IsZero() {
+            publishedAt = r.PublishedAt.Format("2006-01-02 15:04:05")
+        }
+
+        outputTable.AppendRow(table.Row{r.ID, r.TagName, r.Name, status, r.CreatedAt.Format("2006-01-02 15:04:05"), publishedAt, r.HtmlUrl})
+    }
+
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintReleaseChecklist prints the items of the checklist of a release as table.
+func (t *TablePrinter) PrintReleaseChecklist(checklist domain.ReleaseChecklist) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"#", "Checked", "Author", "Item"})
+
+    for _, i := range checklist.Items {
+        checked := ""
+        if i.Checked {
+            checked = "Yes"
+        }
+
+        outputTable.AppendRow(table.Row{i.Line, checked, i.Author, i.Text})
+    }
+
+    captionText := fmt.Sprintf("%v out of %v items are checked.", checklist.Checked, len(checklist.Items))
+    if len(checklist.UncheckedAuthors) > 0 {
+        captionText = fmt.Sprintf("%v Unverified authors : %v", captionText, strings.Join(checklist.UncheckedAuthors, ", "))
+    }
+
+    outputTable.SetCaption(captionText)
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
 // PrintReleaseReport prints release report details as table.
 func (t *TablePrinter) PrintReleaseReport(releaseReport domain.ReleaseReport, captionText string) {
     outputTable := table.NewWriter()
@@ -139,6 +198,26 @@ func (t *TablePrinter) PrintReleaseReport(releaseReport domain.ReleaseReport, ca
     outputTable.Render()
 }
 
+// PrintReleaseReportBreakdown prints the release report of each repository along with the combined one.
+func (t *TablePrinter) PrintReleaseReportBreakdown(repositoryReports []domain.RepositoryReleaseReport, releaseReport domain.ReleaseReport, captionText string) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+
+    outputTable.AppendHeader(table.Row{"Repository", "Draft", "Pre-Releases", "Created", "Published", "Ratio"})
+    for _, r := range repositoryReports {
+        outputTable.AppendRow(table.Row{r.Repository, r.NumberOfDraftReleases, r.NumberOfPreReleases, r.NumberOfReleasesCreated, r.NumberOfReleasesPublished, r.CreatedToPublishedRatio})
+    }
+
+    outputTable.AppendFooter(table.Row{"Total", releaseReport.NumberOfDraftReleases, releaseReport.NumberOfPreReleases, releaseReport.NumberOfReleasesCreated, releaseReport.NumberOfReleasesPublished, releaseReport.CreatedToPublishedRatio})
+
+    if captionText != "" {
+        outputTable.SetCaption(fmt.Sprintf("%v", captionText))
+    }
+
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
 // PrintWorkflowCosts prints cost details of workflows.
 func (t *TablePrinter) PrintWorkflowCosts(workflowBilling []domain.WorkflowBilling) {
     outputTable := table.NewWriter()
@@ -181,6 +260,437 @@ func (t *TablePrinter) PrintWorkflowCosts(workflowBilling []domain.WorkflowBilli
     outputTable.Render()
 }
 
+// PrintAgingReport prints the aging details of pull requests as well as their distribution in age buckets.
+func (t *TablePrinter) PrintAgingReport(agingReport domain.AgingReport) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Repository", "#", "Title", "Author", "Age", "Inactive For", "Last Push", "Last Review", "Last Comment", "Notes"})
+
+    for _, p := range agingReport.PullRequests {
+        var notes []string
+        if p.IsStale {
+            notes = append(notes, "STALE")
+        }
+        if p.Activity.HasUnaddressedChangeRequests {
+            notes = append(notes, "CHANGES NOT ADDRESSED")
+        }
+        if p.Activity.IsApproved {
+            notes = append(notes, "APPROVED NOT MERGED")
+        }
+
+        outputTable.AppendRow(table.Row{
+            p.Repository,
+            p.Number,
+            p.Title,
+            p.Author,
+            p.StrAge,
+            p.StrInactivity,
+            formatOptionalDate(p.Activity.LastPushAt),
+            formatOptionalDate(p.Activity.LastReviewAt),
+            formatOptionalDate(p.Activity.LastCommentAt),
+            strings.Join(notes, ", "),
+        })
+    }
+
+    outputTable.AppendSeparator()
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+
+    summaryTable := table.NewWriter()
+    summaryTable.SetOutputMirror(os.Stdout)
+    summaryTable.AppendHeader(table.Row{"Label", "Value"})
+    summaryTable.AppendRow(table.Row{"Stale", agingReport.Stale})
+    summaryTable.AppendRow(table.Row{"Approved not merged", agingReport.ApprovedNotMerged})
+    summaryTable.AppendRow(table.Row{"Changes not addressed", agingReport.UnaddressedChanges})
+    summaryTable.AppendRow(table.Row{"Created by bots", agingReport.Automated})
+    summaryTable.SetStyle(table.StyleBold)
+    summaryTable.Render()
+
+    bucketTable := table.NewWriter()
+    bucketTable.SetOutputMirror(os.Stdout)
+    bucketTable.AppendHeader(table.Row{"Bucket", "By Age", "By Inactivity"})
+
+    for _, b := range agingReport.Buckets {
+        bucketTable.AppendRow(table.Row{b.Bucket, b.ByAge, b.ByInactivity})
+    }
+
+    bucketTable.SetStyle(table.StyleBold)
+    bucketTable.Render()
+}
+
+// PrintPullRequestSizeReport prints the size distribution of the pull requests.
+func (t *TablePrinter) PrintPullRequestSizeReport(sizeReport domain.PullRequestSizeReport) {
+    bucketTable := table.NewWriter()
+    bucketTable.SetOutputMirror(os.Stdout)
+    bucketTable.AppendHeader(table.Row{"Size", "Pull Requests", "Lines", "Avg Lead Time"})
+
+    periodHeader := table.Row{"Period"}
+    for _, b := range sizeReport.Buckets {
+        bucketTable.AppendRow(table.Row{b.Size, b.Count, b.Lines, b.StrAvgLeadTime})
+        periodHeader = append(periodHeader, b.Size)
+    }
+
+    bucketTable.AppendSeparator()
+    bucketTable.AppendFooter(table.Row{"Correlation", "", "", fmt.Sprintf("%.2f", sizeReport.LeadTimeCorrelation)})
+    bucketTable.SetStyle(table.StyleBold)
+    bucketTable.Render()
+
+    periodTable := table.NewWriter()
+    periodTable.SetOutputMirror(os.Stdout)
+    periodTable.AppendHeader(periodHeader)
+
+    for _, p := range sizeReport.Periods {
+        row := table.Row{p.Period}
+        for _, b := range sizeReport.Buckets {
+            row = append(row, p.Sizes[b.Size])
+        }
+        periodTable.AppendRow(row)
+    }
+
+    periodTable.SetStyle(table.StyleBold)
+    periodTable.Render()
+
+    if len(sizeReport.Oversized) == 0 {
+        return
+    }
+
+    oversizedTable := table.NewWriter()
+    oversizedTable.SetOutputMirror(os.Stdout)
+    oversizedTable.AppendHeader(table.Row{"#", "Title", "Lines", "Changed Files", "Lead Time"})
+
+    for _, p := range sizeReport.Oversized {
+        oversizedTable.AppendRow(table.Row{p.Number, p.Title, p.SizeLines, p.ChangedFiles, p.StrLeadTime})
+    }
+
+    oversizedTable.SetCaption("Top oversized pull requests.")
+    oversizedTable.SetStyle(table.StyleBold)
+    oversizedTable.Render()
+}
+
+// PrintCycleTimeReport prints the time that the pull requests spent in each stage of their lifecycle.
+func (t *TablePrinter) PrintCycleTimeReport(cycleTimeReport domain.CycleTimeReport) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"#", "Title", "Author", "Coding", "Draft", "Waiting For Review", "In Review", "Waiting To Merge"})
+
+    for _, p := range cycleTimeReport.PullRequests {
+        outputTable.AppendRow(table.Row{p.Number, p.Title, p.Author, p.StrCoding, p.StrDraft, p.StrWaitingForReview, p.StrInReview, p.StrWaitingToMerge})
+    }
+
+    average := cycleTimeReport.Average
+    share := func(stage time.Duration) string {
+        if average.GetTotal() == 0 {
+            return "0.00%"
+        }
+        return fmt.Sprintf("%.2f%%", float64(stage)/float64(average.GetTotal())*100)
+    }
+
+    outputTable.AppendSeparator()
+    outputTable.AppendRow(table.Row{"", "Average", "", average.StrCoding, average.StrDraft, average.StrWaitingForReview, average.StrInReview, average.StrWaitingToMerge})
+    outputTable.AppendSeparator()
+    outputTable.AppendRow(table.Row{"", "Share", "", share(average.Coding), share(average.Draft), share(average.WaitingForReview), share(average.InReview), share(average.WaitingToMerge)})
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintAutomationSummary prints the summary of the pull requests created by bots and automation accounts.
+func (t *TablePrinter) PrintAutomationSummary(automationSummary domain.AutomationSummary) {
+    summaryTable := table.NewWriter()
+    summaryTable.SetOutputMirror(os.Stdout)
+    summaryTable.AppendHeader(table.Row{"Label", "Value"})
+    summaryTable.AppendRow(table.Row{"Automated pull requests", automationSummary.PullRequests})
+    summaryTable.AppendRow(table.Row{"Merged", automationSummary.Merged})
+    summaryTable.AppendRow(table.Row{"Avg lead time", automationSummary.StrAvgLeadTime})
+    summaryTable.SetCaption("Pull requests created by bots and automation accounts.")
+    summaryTable.SetStyle(table.StyleBold)
+    summaryTable.Render()
+
+    var authors []string
+    for author := range automationSummary.Authors {
+        authors = append(authors, author)
+    }
+    sort.Strings(authors)
+
+    authorsTable := table.NewWriter()
+    authorsTable.SetOutputMirror(os.Stdout)
+    authorsTable.AppendHeader(table.Row{"Author", "Pull Requests"})
+
+    for _, author := range authors {
+        authorsTable.AppendRow(table.Row{author, automationSummary.Authors[author]})
+    }
+
+    authorsTable.SetStyle(table.StyleBold)
+    authorsTable.Render()
+}
+
+// PrintPullRequestMetricsDelta prints the changes of the pull request metrics compared to the baseline period.
+func (t *TablePrinter) PrintPullRequestMetricsDelta(metricsDelta domain.PullRequestMetricsDelta) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Metric", "Baseline", "Current", "Delta", "Change"})
+
+    outputTable.AppendRow(getDeltaRow("Pull requests", metricsDelta.PullRequests, "%.0f", false))
+    outputTable.AppendRow(getDeltaRow("Merged", metricsDelta.Merged, "%.0f", false))
+    outputTable.AppendRow(getDeltaRow("Avg lead time (hours)", metricsDelta.AvgLeadTime, "%.2f", true))
+    outputTable.AppendRow(getDeltaRow("Avg time to merge (hours)", metricsDelta.AvgTimeToMerge, "%.2f", true))
+    outputTable.AppendRow(getDeltaRow("Avg size (lines)", metricsDelta.AvgSize, "%.2f", true))
+    outputTable.AppendRow(getDeltaRow("Flow ratio", metricsDelta.FlowRatio, "%.2f", true))
+
+    outputTable.SetCaption(fmt.Sprintf("Compared to the period from %v to %v.", metricsDelta.BaselineStartDate, metricsDelta.BaselineEndDate))
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintCategoryBreakdown prints the share of the pull requests and of the modified lines per category.
+func (t *TablePrinter) PrintCategoryBreakdown(categoryBreakdown []domain.CategoryBreakdown) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Category", "Pull Requests", "Pull Requests %", "Lines", "Lines %"})
+
+    for _, c := range categoryBreakdown {
+        outputTable.AppendRow(table.Row{c.Category, c.PullRequests, fmt.Sprintf("%.2f%%", c.PullRequestsPercentage), c.Lines, fmt.Sprintf("%.2f%%", c.LinesPercentage)})
+    }
+
+    outputTable.SetCaption("Investment split per category.")
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintCategoryPeriods prints the share of the pull requests and of the modified lines per category for each period,
+// leaving out the categories without any pull requests during the period.
+func (t *TablePrinter) PrintCategoryPeriods(categoryPeriods []domain.CategoryPeriod) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Period", "Category", "Pull Requests", "Pull Requests %", "Lines", "Lines %"})
+
+    for idx, p := range categoryPeriods {
+        if idx > 0 {
+            outputTable.AppendSeparator()
+        }
+
+        for _, c := range p.Categories {
+            if c.PullRequests == 0 {
+                continue
+            }
+
+            outputTable.AppendRow(table.Row{p.Period, c.Category, c.PullRequests, fmt.Sprintf("%.2f%%", c.PullRequestsPercentage), c.Lines, fmt.Sprintf("%.2f%%", c.LinesPercentage)})
+        }
+    }
+
+    outputTable.SetCaption("Investment split per category and period.")
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintHotspotReport prints the most frequently modified files and directories.
+func (t *TablePrinter) PrintHotspotReport(hotspotReport domain.HotspotReport) {
+    filesTable := table.NewWriter()
+    filesTable.SetOutputMirror(os.Stdout)
+    filesTable.AppendHeader(table.Row{"File", "Changes", "Additions", "Deletions", "Churn", "Authors"})
+
+    for _, f := range hotspotReport.Files {
+        filesTable.AppendRow(table.Row{f.Path, f.Changes, f.Additions, f.Deletions, f.Churn, strings.Join(f.Authors, ", ")})
+    }
+
+    filesTable.SetCaption("Most frequently modified files.")
+    filesTable.SetStyle(table.StyleBold)
+    filesTable.Render()
+
+    fmt.Println()
+
+    directoriesTable := table.NewWriter()
+    directoriesTable.SetOutputMirror(os.Stdout)
+    directoriesTable.AppendHeader(table.Row{"Directory", "Changes", "Files", "Additions", "Deletions", "Churn", "Authors"})
+
+    for _, d := range hotspotReport.Directories {
+        directoriesTable.AppendRow(table.Row{d.Path, d.Changes, d.Files, d.Additions, d.Deletions, d.Churn, strings.Join(d.Authors, ", ")})
+    }
+
+    directoriesTable.SetCaption("Most frequently modified directories.")
+    directoriesTable.SetStyle(table.StyleBold)
+    directoriesTable.Render()
+
+    if len(hotspotReport.Owners) == 0 {
+        return
+    }
+
+    fmt.Println()
+
+    ownersTable := table.NewWriter()
+    ownersTable.SetOutputMirror(os.Stdout)
+    ownersTable.AppendHeader(table.Row{"Owner", "Changes", "Files", "Additions", "Deletions", "Churn", "Authors"})
+
+    for _, o := range hotspotReport.Owners {
+        ownersTable.AppendRow(table.Row{o.Owner, o.Changes, o.Files, o.Additions, o.Deletions, o.Churn, strings.Join(o.Authors, ", ")})
+    }
+
+    ownersTable.SetCaption("Most frequently modified files per code owner.")
+    ownersTable.SetStyle(table.StyleBold)
+    ownersTable.Render()
+}
+
+// PrintHotspotReportCsv prints the most frequently modified files and directories in csv format.
+func (t *TablePrinter) PrintHotspotReportCsv(hotspotReport domain.HotspotReport) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Type", "Path", "Changes", "Files", "Additions", "Deletions", "Churn", "Authors"})
+
+    for _, f := range hotspotReport.Files {
+        outputTable.AppendRow(table.Row{"file", f.Path, f.Changes, 1, f.Additions, f.Deletions, f.Churn, strings.Join(f.Authors, ";")})
+    }
+
+    for _, d := range hotspotReport.Directories {
+        outputTable.AppendRow(table.Row{"directory", d.Path, d.Changes, d.Files, d.Additions, d.Deletions, d.Churn, strings.Join(d.Authors, ";")})
+    }
+
+    for _, o := range hotspotReport.Owners {
+        outputTable.AppendRow(table.Row{"owner", o.Owner, o.Changes, o.Files, o.Additions, o.Deletions, o.Churn, strings.Join(o.Authors, ";")})
+    }
+
+    outputTable.RenderCSV()
+}
+
+// PrintOwnerBreakdown prints the pull requests per code owner.
+func (t *TablePrinter) PrintOwnerBreakdown(ownerBreakdown []domain.OwnerBreakdown) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Owner", "Pull Requests", "Merged", "Lines", "Avg Lead Time"})
+
+    for _, o := range ownerBreakdown {
+        outputTable.AppendRow(table.Row{o.Owner, o.PullRequests, o.Merged, o.Lines, o.StrAvgLeadTime})
+    }
+
+    outputTable.SetCaption("Pull requests per code owner.")
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintRepositoryBreakdown prints the pull requests per repository.
+func (t *TablePrinter) PrintRepositoryBreakdown(repositoryBreakdown []domain.RepositoryBreakdown) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Repository", "Pull Requests", "Merged", "Lines", "Avg Lead Time", "Avg Time to Merge"})
+
+    for _, r := range repositoryBreakdown {
+        outputTable.AppendRow(table.Row{r.Repository, r.PullRequests, r.Merged, r.Lines, r.StrAvgLeadTime, r.StrAvgTimeToMerge})
+    }
+
+    outputTable.SetCaption("Pull requests per repository.")
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintCodeOwnersReport prints the files without owners and the pull requests merged without the approval of an owner.
+func (t *TablePrinter) PrintCodeOwnersReport(codeOwnersReport domain.CodeOwnersReport) {
+    filesTable := table.NewWriter()
+    filesTable.SetOutputMirror(os.Stdout)
+    filesTable.AppendHeader(table.Row{"#", "Unowned File"})
+
+    for idx, f := range codeOwnersReport.UnownedFiles {
+        filesTable.AppendRow(table.Row{idx + 1, f})
+    }
+
+    filesTable.SetCaption(fmt.Sprintf("%v out of %v files do not have an owner in %v.", len(codeOwnersReport.UnownedFiles), codeOwnersReport.Files, codeOwnersReport.Path))
+    filesTable.SetStyle(table.StyleBold)
+    filesTable.Render()
+
+    if codeOwnersReport.PullRequests == 0 {
+        return
+    }
+
+    fmt.Println()
+
+    violationsTable := table.NewWriter()
+    violationsTable.SetOutputMirror(os.Stdout)
+    violationsTable.AppendHeader(table.Row{"#", "Title", "Author", "Merged At", "Missing Owners", "Unapproved Files"})
+
+    for _, v := range codeOwnersReport.Violations {
+        violationsTable.AppendRow(table.Row{v.Number, v.Title, v.Author, v.MergedAt.Format("2006-01-02 15:04:05"), strings.Join(v.MissingOwners, ", "), len(v.UnapprovedFiles)})
+    }
+
+    violationsTable.SetCaption(fmt.Sprintf("%v out of %v merged pull requests did not get the approval of an owner.", len(codeOwnersReport.Violations), codeOwnersReport.PullRequests))
+    violationsTable.SetStyle(table.StyleBold)
+    violationsTable.Render()
+}
+
+// PrintKnowledgeReport prints the knowledge concentration of the directories of a repository.
+func (t *TablePrinter) PrintKnowledgeReport(knowledgeReport domain.KnowledgeReport) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Directory", "Changes", "Churn", "Contributors", "Top Contributor", "Top Contributor %", "Bus Factor", "Concentrated"})
+
+    for _, d := range knowledgeReport.Directories {
+        concentrated := "No"
+        if d.IsConcentrated {
+            concentrated = text.Colors{text.FgRed}.Sprint("Yes")
+        }
+
+        outputTable.AppendRow(table.Row{d.Path, d.Changes, d.Churn, len(d.Contributors), d.TopContributor, fmt.Sprintf("%.2f%%", d.TopContributorShare), d.BusFactor, concentrated})
+    }
+
+    outputTable.SetCaption(fmt.Sprintf("%v out of %v directories depend mostly on a single contributor.", knowledgeReport.Concentrated, len(knowledgeReport.Directories)))
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintReviewGraph prints the edges of a review graph along with its summary.
+func (t *TablePrinter) PrintReviewGraph(reviewGraph domain.ReviewGraph) {
+    edgesTable := table.NewWriter()
+    edgesTable.SetOutputMirror(os.Stdout)
+    edgesTable.AppendHeader(table.Row{"Author", "Reviewer", "Pull Requests"})
+
+    for _, e := range reviewGraph.Edges {
+        edgesTable.AppendRow(table.Row{e.Author, e.Reviewer, e.Weight})
+    }
+
+    edgesTable.SetCaption("Pull requests of each author reviewed by each reviewer.")
+    edgesTable.SetStyle(table.StyleBold)
+    edgesTable.Render()
+
+    fmt.Println()
+
+    stats := reviewGraph.Stats
+    summaryTable := table.NewWriter()
+    summaryTable.SetOutputMirror(os.Stdout)
+    summaryTable.AppendHeader(table.Row{"Label", "Value"})
+    summaryTable.AppendRow(table.Row{"Pull requests", stats.PullRequests})
+    summaryTable.AppendRow(table.Row{"Unreviewed pull requests", stats.Unreviewed})
+    summaryTable.AppendRow(table.Row{"Authors", stats.Authors})
+    summaryTable.AppendRow(table.Row{"Reviewers", stats.Reviewers})
+    summaryTable.AppendRow(table.Row{"Reciprocity", fmt.Sprintf("%.2f%%", stats.Reciprocity)})
+    summaryTable.AppendRow(table.Row{"Reviewers of a single author", strings.Join(stats.SingleAuthorReviewers, ", ")})
+    summaryTable.AppendRow(table.Row{"Authors reviewed by a single reviewer", strings.Join(stats.SingleReviewerAuthors, ", ")})
+    summaryTable.SetStyle(table.StyleBold)
+    summaryTable.Render()
+}
+
+// PrintReviewGraphDot prints a review graph in graphviz dot format.
+func (t *TablePrinter) PrintReviewGraphDot(reviewGraph domain.ReviewGraph) {
+    fmt.Println("digraph reviews {")
+    for _, e := range reviewGraph.Edges {
+        fmt.Printf("  %q -> %q [label=%q, weight=%d];\n", e.Author, e.Reviewer, fmt.Sprint(e.Weight), e.Weight)
+    }
+    fmt.Println("}")
+}
+
+// PrintReviewGraphMermaid prints a review graph as a mermaid flowchart.
+func (t *TablePrinter) PrintReviewGraphMermaid(reviewGraph domain.ReviewGraph) {
+    // The node ids are assigned by order of appearance, since usernames that only differ in punctuation would
+    // otherwise end up with the same id.
+    nodeIDs := make(map[string]string)
+    nodeID := func(username string) string {
+        if _, ok := nodeIDs[username]; !ok {
+            nodeIDs[username] = fmt.Sprintf("u%d", len(nodeIDs))
+        }
+        return nodeIDs[username]
+    }
+
+    fmt.Println("graph LR")
+    for _, e := range reviewGraph.Edges {
+        fmt.Printf("  %v[\"%v\"] -->|%d| %v[\"%v\"]\n", nodeID(e.Author), e.Author, e.Weight, nodeID(e.Reviewer), e.Reviewer)
+    }
+}
+
 func (t *TablePrinter) getTotalAndAverageRows(totalData domain.TotalAggregation, averageData domain.AverageAggregation) (table.Row, table.Row) {
     totalTableRow := table.Row{
         "",
@@ -212,3 +722,83 @@ func (t *TablePrinter) getTotalAndAverageRows(totalData domain.TotalAggregation,
 
     return totalTableRow, averageTableRow
 }
+
+func formatOptionalDate(date time.Time) string {
+    if date.IsZero() {
+        return "-"
+    }
+
+    return date.Format("2006-01-02 15:04")
+}
+
+// getDeltaRow prepares the row of a metric delta, using an arrow to show the direction of the change and a color to
+// show whether the change is an improvement or not.
+func getDeltaRow(name string, delta domain.MetricDelta, valueFormat string, lowerIsBetter bool) table.Row {
+    arrow, color := "=", text.Colors{}
+    if delta.Absolute != 0 {
+        improved := (delta.Absolute < 0) == lowerIsBetter
+
+        arrow = "▲"
+        if delta.Absolute < 0 {
+            arrow = "▼"
+        }
+
+        color = text.Colors{text.FgRed}
+        if improved {
+            color = text.Colors{text.FgGreen}
+        }
+    }
+
+    return table.Row{
+        name,
+        fmt.Sprintf(valueFormat, delta.Baseline),
+        fmt.Sprintf(valueFormat, delta.Current),
+        color.Sprintf("%s %+.2f", arrow, delta.Absolute),
+        color.Sprintf("%+.2f%%", delta.Percentage),
+    }
+}
+
+// PrintReviewerSuggestion prints the ranked candidate reviewers of a pull request.
+func (t *TablePrinter) PrintReviewerSuggestion(reviewerSuggestion domain.ReviewerSuggestion) {
+    requested := make(map[string]bool)
+    for _, r := range reviewerSuggestion.Requested {
+        requested[r] = true
+    }
+
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"#", "Candidate", "Score", "Owned Files", "Authored Files", "Recent Commits", "Open Reviews", "Team Member", "Requested"})
+
+    for idx, c := range reviewerSuggestion.Candidates {
+        teamMember := "No"
+        if c.IsTeamMember {
+            teamMember = "Yes"
+        }
+
+        isRequested := ""
+        if requested[c.Username] {
+            isRequested = text.Colors{text.FgGreen}.Sprint("Yes")
+        }
+
+        outputTable.AppendRow(table.Row{idx + 1, c.Username, fmt.Sprintf("%.2f", c.Score), c.OwnedFiles, c.AuthoredFiles, c.RecentCommits, c.OpenReviews, teamMember, isRequested})
+    }
+
+    outputTable.SetCaption(fmt.Sprintf("Candidate reviewers of pull request #%v by %v, based on %v modified files.", reviewerSuggestion.Number, reviewerSuggestion.Author, reviewerSuggestion.Files))
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
+
+// PrintInbox prints the pull requests that wait for an action of the user.
+func (t *TablePrinter) PrintInbox(inboxItems []domain.InboxItem) {
+    outputTable := table.NewWriter()
+    outputTable.SetOutputMirror(os.Stdout)
+    outputTable.AppendHeader(table.Row{"Reason", "Waiting", "Repository", "#", "Title", "Author", "Link"})
+
+    for _, i := range inboxItems {
+        outputTable.AppendRow(table.Row{i.Reason, i.StrWaiting, i.Repository, i.Number, i.Title, i.Author, i.HtmlUrl})
+    }
+
+    outputTable.SetCaption(fmt.Sprintf("%v pull requests wait for you.", len(inboxItems)))
+    outputTable.SetStyle(table.StyleBold)
+    outputTable.Render()
+}
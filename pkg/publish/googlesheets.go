@@ -92,6 +92,9 @@ func (s *GoogleSheetsService) WriteOverallSheetHeader(spreadsheetID string, shee
 			"Flow Rate Created",
 			"Flow Rate Merged",
 			"Flow Ratio",
+			"Automated Pull Requests",
+			"Automated Merged",
+			"Automated Lead Time Avg",
 		},
 	}
 
@@ -139,6 +142,9 @@ func (s *GoogleSheetsService) WritePullRequestReportData(spreadsheetID string, s
 			prFlowRatio.Created,
 			prFlowRatio.Merged,
 			convertStringToFloatWithTwoDecimals(prFlowRatio.Ratio),
+			prMetrics.Automation.PullRequests,
+			prMetrics.Automation.Merged,
+			convertDurationToHourDecimal(prMetrics.Automation.AvgLeadTime),
 		},
 	}
 
@@ -216,6 +222,69 @@ func (s *GoogleSheetsService) WriteReleaseReportData(spreadsheetID string, sheet
 	return nil
 }
 
+// WriteCategoryOverallSheetHeader in the provided category overall data sheet. This function shall be used only in case the overall data sheet does not exist.
+func (s *GoogleSheetsService) WriteCategoryOverallSheetHeader(spreadsheetID string, sheetName string) error {
+	var vr sheets.ValueRange
+
+	rangeInSheet := fmt.Sprintf("%v!A1", sheetName)
+
+	listOfValues := [][]interface{}{
+		{
+			"#",
+			"Sprint Name",
+			"Start Date",
+			"End Date",
+			"Category",
+			"Pull Requests",
+			"Pull Requests %",
+			"Modified Lines",
+			"Modified Lines %",
+		},
+	}
+
+	vr.Values = listOfValues
+
+	_, err := s.Spreadsheets.Values.Update(spreadsheetID, rangeInSheet, &vr).ValueInputOption("RAW").Do()
+	if err != nil {
+		fmt.Printf("Failed to write data in spreadsheet with error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// WriteCategoryReportData to the provided spreadsheet, using a line for each one of the categories.
+func (s *GoogleSheetsService) WriteCategoryReportData(spreadsheetID string, sheetName string, cellRange string, sprint *domain.SprintSummary, categoryBreakdown []domain.CategoryBreakdown) error {
+	var vr sheets.ValueRange
+
+	rangeInSheet := fmt.Sprintf("%v!%v", sheetName, cellRange)
+
+	var listOfValues [][]interface{}
+	for _, c := range categoryBreakdown {
+		listOfValues = append(listOfValues, []interface{}{
+			sprint.Number,
+			sprint.Name,
+			sprint.StartDate.Format("2006-01-02"),
+			sprint.EndDate.Format("2006-01-02"),
+			c.Category,
+			c.PullRequests,
+			convertToFloatWithTwoDecimals(c.PullRequestsPercentage),
+			c.Lines,
+			convertToFloatWithTwoDecimals(c.LinesPercentage),
+		})
+	}
+
+	vr.Values = listOfValues
+
+	_, err := s.Spreadsheets.Values.Append(spreadsheetID, rangeInSheet, &vr).ValueInputOption("RAW").Do()
+	if err != nil {
+		fmt.Printf("Failed to write data in spreadsheet with error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 // CreateAndCleanupOverallSheet checks if the overall sheet exists, creates it if it doesn't exist and add the respective header line.
 func (s *GoogleSheetsService) CreateAndCleanupOverallSheet(spreadsheetID string, sheetName string) error {
 	sheetExists, err := s.CheckIfSheetExists(spreadsheetID, sheetName)
@@ -270,6 +339,33 @@ func (s *GoogleSheetsService) CreateAndCleanupReleaseOverallSheet(spreadsheetID
 	return nil
 }
 
+// CreateAndCleanupCategoryOverallSheet checks if the overall sheet for categories exists, creates it if it doesn't and add the respective header line.
+func (s *GoogleSheetsService) CreateAndCleanupCategoryOverallSheet(spreadsheetID string, sheetName string) error {
+	sheetExists, err := s.CheckIfSheetExists(spreadsheetID, sheetName)
+	if err != nil {
+		fmt.Printf("Error checking if sheet exists in spreadsheet: %v\n", err)
+		return err
+	}
+
+	if sheetExists {
+		return nil
+	}
+
+	err = s.CreateSheet(spreadsheetID, sheetName)
+	if err != nil {
+		fmt.Printf("Error creating the sheet %q in spreadsheet: %v\n", sheetName, err)
+		return err
+	}
+
+	err = s.WriteCategoryOverallSheetHeader(spreadsheetID, sheetName)
+	if err != nil {
+		fmt.Printf("Error to write the header line in overall data sheet %q in spreadsheet: %v\n", sheetName, err)
+		return err
+	}
+
+	return nil
+}
+
 // CreateSheet in an existing spreadsheet with a given name.
 func (s *GoogleSheetsService) CreateSheet(spreadsheetID string, sheetName string) error {
 	req := sheets.Request{
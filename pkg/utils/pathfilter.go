@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RegexPatternPrefix is the prefix of the path patterns that are regular expressions.
+const RegexPatternPrefix = "re:"
+
+// PathFilter matches the paths of files against include and exclude patterns. A pattern prefixed with 're:' is a
+// regular expression that is searched for in the path. A pattern with any of the '*', '?' or '[' characters is a glob
+// that is matched against the full path, where '**' matches any number of directories, or against the file name in case
+// the pattern has no '/'. A pattern ending with '/' matches the files under a directory of that name at any depth,
+// while any other pattern matches the paths that contain it.
+type PathFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// NewPathFilter creates and returns a path filter for the include and exclude patterns.
+func NewPathFilter(include, exclude []string) (*PathFilter, error) {
+	includeRegexps, err := compilePathPatterns(include)
+	if err != nil {
+		return nil, err
+	}
+
+	excludeRegexps, err := compilePathPatterns(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PathFilter{
+		include: includeRegexps,
+		exclude: excludeRegexps,
+	}, nil
+}
+
+// IsEmpty checks whether the filter has no patterns at all, in which case every path matches it.
+func (f *PathFilter) IsEmpty() bool {
+	return len(f.include) == 0 && len(f.exclude) == 0
+}
+
+// Matches checks whether the path matches any of the include patterns, or there are none, and none of the exclude ones.
+func (f *PathFilter) Matches(filename string) bool {
+	if len(f.include) > 0 && !matchesAny(filename, f.include) {
+		return false
+	}
+
+	return !matchesAny(filename, f.exclude)
+}
+
+// Excludes checks whether the path matches any of the exclude patterns, regardless of the include ones.
+func (f *PathFilter) Excludes(filename string) bool {
+	return matchesAny(filename, f.exclude)
+}
+
+// compilePathPatterns compiles each of the path patterns to a regular expression.
+func compilePathPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var regexps []*regexp.Regexp
+	for _, p := range patterns {
+		expr := regexp.QuoteMeta(p)
+		switch {
+		case strings.HasPrefix(p, RegexPatternPrefix):
+			expr = strings.TrimPrefix(p, RegexPatternPrefix)
+		case strings.ContainsAny(p, "*?["):
+			prefix := "^(?:.*/)?"
+			if strings.Contains(p, "/") {
+				prefix = "^"
+			}
+			expr = prefix + GlobToRegex(p) + "$"
+		case strings.HasSuffix(p, "/"):
+			expr = "(?:^|/)" + regexp.QuoteMeta(strings.TrimPrefix(p, "/"))
+		}
+
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path pattern %q : %v", p, err)
+		}
+
+		regexps = append(regexps, re)
+	}
+
+	return regexps, nil
+}
+
+// GlobToRegex converts a glob pattern to a regular expression, where '*' and '?' match within a directory, '**'
+// matches any number of directories and '[...]' is a character class. The expression is not anchored, so that the
+// callers can decide which parts of the path it needs to match.
+func GlobToRegex(glob string) string {
+	var sb strings.Builder
+
+	for idx := 0; idx < len(glob); idx++ {
+		switch c := glob[idx]; {
+		case strings.HasPrefix(glob[idx:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			idx += 2
+		case strings.HasPrefix(glob[idx:], "**"):
+			sb.WriteString(".*")
+			idx++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[idx:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+
+			class := glob[idx+1 : idx+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			sb.WriteString("[" + class + "]")
+			idx += end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
+
+// matchesAny checks whether the path matches any of the regular expressions.
+func matchesAny(filename string, regexps []*regexp.Regexp) bool {
+	filename = path.Clean(filename)
+	for _, re := range regexps {
+		if re.MatchString(filename) {
+			return true
+		}
+	}
+
+	return false
+}
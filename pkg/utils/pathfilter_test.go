@@ -0,0 +1,161 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/eujoy/gitpr/pkg/utils"
+)
+
+func TestPathFilter(t *testing.T) {
+	type input struct {
+		include  []string
+		exclude  []string
+		filename string
+	}
+
+	testCases := map[string]struct {
+		input    input
+		expected bool
+	}{
+		"No patterns match any path": {
+			input{nil, nil, "services/api/main.go"},
+			true,
+		},
+		"Plain pattern matches the paths that contain it": {
+			input{[]string{"services/api"}, nil, "services/api-gateway/main.go"},
+			true,
+		},
+		"Plain pattern does not match other paths": {
+			input{[]string{"services/api"}, nil, "services/billing/main.go"},
+			false,
+		},
+		"Directory pattern matches the directories of that name at any depth": {
+			input{[]string{"vendor/"}, nil, "app/vendor/lib/lib.go"},
+			true,
+		},
+		"Directory pattern does not match directories that end with its name": {
+			input{[]string{"vendor/"}, nil, "myvendor/lib.go"},
+			false,
+		},
+		"Glob with double star matches nested directories": {
+			input{[]string{"services/*/cmd/**"}, nil, "services/api/cmd/server/main.go"},
+			true,
+		},
+		"Glob with double star in the middle matches no directories as well": {
+			input{[]string{"services/**/*.go"}, nil, "services/main.go"},
+			true,
+		},
+		"Glob with single star does not cross directories": {
+			input{[]string{"services/*.go"}, nil, "services/api/main.go"},
+			false,
+		},
+		"Glob without a slash matches the file name": {
+			input{[]string{"*.proto"}, nil, "services/api/proto/user.proto"},
+			true,
+		},
+		"Glob with character class": {
+			input{[]string{"services/[!b]*/**"}, nil, "services/billing/main.go"},
+			false,
+		},
+		"Regular expression is searched for in the path": {
+			input{[]string{`re:^services/(api|auth)/`}, nil, "services/auth/handler.go"},
+			true,
+		},
+		"Exclude pattern takes precedence over the include ones": {
+			input{[]string{"services/api/"}, []string{"**/*_test.go"}, "services/api/handler_test.go"},
+			false,
+		},
+		"Exclude pattern without include patterns": {
+			input{nil, []string{`re:\.md$`, "docs/"}, "services/api/main.go"},
+			true,
+		},
+		"Path matching an exclude pattern without include patterns": {
+			input{nil, []string{`re:\.md$`, "docs/"}, "services/api/README.md"},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pathFilter, err := utils.NewPathFilter(tc.input.include, tc.input.exclude)
+			if err != nil {
+				t.Fatalf("Expected to get nil as error, but got '%v'", err)
+			}
+
+			actualMatch := pathFilter.Matches(tc.input.filename)
+			if tc.expected != actualMatch {
+				t.Errorf("Expected to get '%v' as match, but got '%v'", tc.expected, actualMatch)
+			}
+		})
+	}
+
+	t.Run("Invalid regular expression", func(t *testing.T) {
+		_, err := utils.NewPathFilter([]string{"re:services/(api"}, nil)
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+}
+
+func TestPathFilterExcludes(t *testing.T) {
+	type input struct {
+		exclude  []string
+		filename string
+	}
+
+	testCases := map[string]struct {
+		input    input
+		expected bool
+	}{
+		"No patterns": {
+			input{nil, "vendor/lib/lib.go"},
+			false,
+		},
+		"Directory pattern at the root": {
+			input{[]string{"vendor/"}, "vendor/lib/lib.go"},
+			true,
+		},
+		"Directory pattern in a nested directory": {
+			input{[]string{"vendor/"}, "app/vendor/lib/lib.go"},
+			true,
+		},
+		"Exact file path": {
+			input{[]string{"go.sum"}, "go.sum"},
+			true,
+		},
+		"Glob against the full path": {
+			input{[]string{"api/*.pb.go"}, "api/user.pb.go"},
+			true,
+		},
+		"Glob against the file name": {
+			input{[]string{"*.pb.go"}, "api/v1/user.pb.go"},
+			true,
+		},
+		"Glob with double star": {
+			input{[]string{"api/**/gen/*.go"}, "api/v1/gen/user.go"},
+			true,
+		},
+		"Include patterns are not taken into account": {
+			input{[]string{"docs/"}, "cmd/main.go"},
+			false,
+		},
+		"No pattern matches": {
+			input{[]string{"vendor/", "*.pb.go", "go.sum"}, "cmd/main.go"},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pathFilter, err := utils.NewPathFilter([]string{"cmd/"}, tc.input.exclude)
+			if err != nil {
+				t.Fatalf("Expected to get nil as error, but got '%v'", err)
+			}
+
+			actualMatch := pathFilter.Excludes(tc.input.filename)
+			if tc.expected != actualMatch {
+				t.Errorf("Expected to get '%v' as match, but got '%v'", tc.expected, actualMatch)
+			}
+		})
+	}
+}
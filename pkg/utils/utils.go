@@ -1,13 +1,24 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
+	"github.com/mattn/go-isatty"
 )
 
 // Utils describes the common utilities package.
@@ -33,6 +44,14 @@ func (u *Utils) ClearTerminalScreen() {
 	}
 }
 
+// IsTerminal checks whether both the input and the output are attached to a terminal, so that the user can be prompted.
+func (u *Utils) IsTerminal() bool {
+	isInputTerminal := isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())
+	isOutputTerminal := isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+
+	return isInputTerminal && isOutputTerminal
+}
+
 // GetPageOptions prepares and returns a list of available options for the user repos list.
 func (u *Utils) GetPageOptions(respLength int, pageSize int, currentPage int) []string {
 	var options []string
@@ -67,6 +86,21 @@ func (u *Utils) GetNextPageNumberOrExit(surveySelection string, currentPage int)
 	return 0, false
 }
 
+// Confirm asks the user to confirm an action, unless it is forced.
+func (u *Utils) Confirm(force bool, message string) (bool, error) {
+	if force {
+		return true, nil
+	}
+
+	confirmed := false
+	err := survey.AskOne(&survey.Confirm{Message: message}, &confirmed)
+	if err != nil {
+		return false, err
+	}
+
+	return confirmed, nil
+}
+
 func (u *Utils) ConvertDurationToString(dur time.Duration) string {
 	if dur == time.Duration(0) {
 		return ""
@@ -81,3 +115,197 @@ func (u *Utils) ConvertDurationToString(dur time.Duration) string {
 
 	return formattedDuration
 }
+
+// ConvertCycleTimeStagesToString fills in the human readable representation of the cycle time stages.
+func (u *Utils) ConvertCycleTimeStagesToString(stages domain.CycleTimeStages) domain.CycleTimeStages {
+	stages.StrCoding = u.ConvertDurationToString(stages.Coding)
+	stages.StrDraft = u.ConvertDurationToString(stages.Draft)
+	stages.StrWaitingForReview = u.ConvertDurationToString(stages.WaitingForReview)
+	stages.StrInReview = u.ConvertDurationToString(stages.InReview)
+	stages.StrWaitingToMerge = u.ConvertDurationToString(stages.WaitingToMerge)
+
+	return stages
+}
+
+// GetValidPullRequestState checks if the requested state of pull requests is allowed and returns the default state of
+// the configuration in case it is not.
+func (u *Utils) GetValidPullRequestState(prState string) string {
+	for _, prs := range u.cfg.Settings.AllowedPullRequestStates {
+		if prState == prs {
+			return prState
+		}
+	}
+
+	return u.cfg.Settings.PullRequestState
+}
+
+// GetDurationBucketLabels prepares and returns the labels of the buckets that are defined by the provided thresholds (in days).
+func (u *Utils) GetDurationBucketLabels(thresholdsInDays []int) []string {
+	thresholds := append([]int{}, thresholdsInDays...)
+	sort.Ints(thresholds)
+
+	if len(thresholds) == 0 {
+		return []string{"all"}
+	}
+
+	labels := []string{fmt.Sprintf("< %dd", thresholds[0])}
+	for i := 1; i < len(thresholds); i++ {
+		labels = append(labels, fmt.Sprintf("%dd - %dd", thresholds[i-1], thresholds[i]))
+	}
+	labels = append(labels, fmt.Sprintf(">= %dd", thresholds[len(thresholds)-1]))
+
+	return labels
+}
+
+// GetDurationBucket returns the label of the bucket that the duration falls in, based on the provided thresholds (in days).
+func (u *Utils) GetDurationBucket(dur time.Duration, thresholdsInDays []int) string {
+	thresholds := append([]int{}, thresholdsInDays...)
+	sort.Ints(thresholds)
+
+	labels := u.GetDurationBucketLabels(thresholds)
+	for i, t := range thresholds {
+		if dur < time.Duration(t)*24*time.Hour {
+			return labels[i]
+		}
+	}
+
+	return labels[len(labels)-1]
+}
+
+// MatchesRepositoryPattern checks if the full name of a repository, in the 'owner/repository' format, matches the
+// provided glob pattern. Unlike the file paths, the pattern is only matched against the full name, so that the owner
+// of the repository is always taken into account.
+func (u *Utils) MatchesRepositoryPattern(repository, pattern string) bool {
+	re, err := regexp.Compile("^" + GlobToRegex(pattern) + "$")
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(repository)
+}
+
+// RunConcurrently calls the function for each index up to the count, running at most as many calls at the same time
+// as the maximum number of concurrent requests of the configuration. No new calls are started after a call fails and
+// the error of the failed call with the lowest index is returned.
+func (u *Utils) RunConcurrently(count int, fn func(idx int) error) error {
+	maxConcurrent := u.cfg.Settings.MaxConcurrentRequests
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	failed := false
+	errs := make([]error, count)
+
+	semaphore := make(chan struct{}, maxConcurrent)
+	for idx := 0; idx < count; idx++ {
+		semaphore <- struct{}{}
+
+		mutex.Lock()
+		stop := failed
+		mutex.Unlock()
+
+		if stop {
+			<-semaphore
+			break
+		}
+
+		wg.Add(1)
+		go func(idx int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := fn(idx); err != nil {
+				mutex.Lock()
+				errs[idx] = err
+				failed = true
+				mutex.Unlock()
+			}
+		}(idx)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAssetFiles expands the glob patterns to the files they match, sorted and without duplicates. Each pattern has to
+// match at least one file, so that a missing asset is not skipped silently, and the files need to have different base
+// names, since the assets are named after them.
+func (u *Utils) GetAssetFiles(patterns []string) ([]string, error) {
+	var files []string
+	listedFiles := make(map[string]bool)
+	baseNames := make(map[string]string)
+
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset pattern %q : %v", pattern, err)
+		}
+
+		matchedFiles := 0
+		for _, m := range matches {
+			info, err := os.Stat(m)
+			if err != nil {
+				return nil, err
+			}
+
+			if info.IsDir() {
+				continue
+			}
+
+			matchedFiles++
+			if listedFiles[m] {
+				continue
+			}
+
+			if f, ok := baseNames[filepath.Base(m)]; ok {
+				return nil, fmt.Errorf("assets %q and %q have the same name", f, m)
+			}
+
+			listedFiles[m] = true
+			baseNames[filepath.Base(m)] = m
+			files = append(files, m)
+		}
+
+		if matchedFiles == 0 {
+			return nil, fmt.Errorf("asset pattern %q does not match any file", pattern)
+		}
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// BuildChecksums calculates the SHA256 checksums of the files and lists them by the base name of the files, in the
+// format of the sha256sum tool.
+func (u *Utils) BuildChecksums(files []string) (string, error) {
+	var sb strings.Builder
+	for _, f := range files {
+		file, err := os.Open(f)
+		if err != nil {
+			return "", err
+		}
+
+		hash := sha256.New()
+		_, err = io.Copy(hash, file)
+		file.Close()
+		if err != nil {
+			return "", fmt.Errorf("failed to read %q : %v", f, err)
+		}
+
+		sb.WriteString(fmt.Sprintf("%v  %v\n", hex.EncodeToString(hash.Sum(nil)), filepath.Base(f)))
+	}
+
+	return sb.String(), nil
+}
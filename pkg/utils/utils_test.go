@@ -1,10 +1,17 @@
 package utils_test
 
 import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/eujoy/gitpr/internal/config"
+	"github.com/eujoy/gitpr/internal/domain"
 	"github.com/eujoy/gitpr/pkg/utils"
 )
 
@@ -123,3 +130,306 @@ func TestGetNextPageNumberOrExit(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDurationBucket(t *testing.T) {
+	var cfg config.Config
+	utilities := utils.New(cfg)
+
+	type input struct {
+		dur              time.Duration
+		thresholdsInDays []int
+	}
+
+	testCases := map[string]struct {
+		input    input
+		expected string
+	}{
+		"Duration shorter than the first threshold": {
+			input{12 * time.Hour, []int{1, 7, 30}},
+			"< 1d",
+		},
+		"Duration between two thresholds": {
+			input{3 * 24 * time.Hour, []int{1, 7, 30}},
+			"1d - 7d",
+		},
+		"Duration equal to a threshold falls in the next bucket": {
+			input{7 * 24 * time.Hour, []int{30, 1, 7}},
+			"7d - 30d",
+		},
+		"Duration longer than the last threshold": {
+			input{45 * 24 * time.Hour, []int{1, 7, 30}},
+			">= 30d",
+		},
+		"No thresholds provided": {
+			input{45 * 24 * time.Hour, []int{}},
+			"all",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualBucket := utilities.GetDurationBucket(tc.input.dur, tc.input.thresholdsInDays)
+
+			if tc.expected != actualBucket {
+				t.Errorf("Expected to get '%v' as bucket, but got '%v'", tc.expected, actualBucket)
+			}
+		})
+	}
+}
+
+func TestGetValidPullRequestState(t *testing.T) {
+	var cfg config.Config
+	cfg.Settings.PullRequestState = "open"
+	cfg.Settings.AllowedPullRequestStates = []string{"open", "closed", "all"}
+	utilities := utils.New(cfg)
+
+	testCases := map[string]struct {
+		prState  string
+		expected string
+	}{
+		"Allowed state is kept": {
+			prState:  "closed",
+			expected: "closed",
+		},
+		"Unknown state falls back to the default one": {
+			prState:  "merged",
+			expected: "open",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualState := utilities.GetValidPullRequestState(tc.prState)
+
+			if tc.expected != actualState {
+				t.Errorf("Expected to get '%v' as state, but got '%v'", tc.expected, actualState)
+			}
+		})
+	}
+}
+
+func TestConvertCycleTimeStagesToString(t *testing.T) {
+	var cfg config.Config
+	utilities := utils.New(cfg)
+
+	stages := domain.CycleTimeStages{
+		Coding:           2 * time.Hour,
+		WaitingForReview: 30 * time.Minute,
+	}
+
+	actualStages := utilities.ConvertCycleTimeStagesToString(stages)
+
+	expectedStages := stages
+	expectedStages.StrCoding = utilities.ConvertDurationToString(2 * time.Hour)
+	expectedStages.StrDraft = utilities.ConvertDurationToString(0)
+	expectedStages.StrWaitingForReview = utilities.ConvertDurationToString(30 * time.Minute)
+	expectedStages.StrInReview = utilities.ConvertDurationToString(0)
+	expectedStages.StrWaitingToMerge = utilities.ConvertDurationToString(0)
+	if !reflect.DeepEqual(expectedStages, actualStages) {
+		t.Errorf("Expected to get '%+v' as stages, but got '%+v'", expectedStages, actualStages)
+	}
+}
+
+func TestMatchesRepositoryPattern(t *testing.T) {
+	var cfg config.Config
+	utilities := utils.New(cfg)
+
+	type input struct {
+		repository string
+		pattern    string
+	}
+
+	testCases := map[string]struct {
+		input    input
+		expected bool
+	}{
+		"Pattern for the repositories of an owner": {
+			input{"eujoy/gitpr", "eujoy/*"},
+			true,
+		},
+		"Pattern for the repositories of another owner": {
+			input{"other/gitpr", "eujoy/*"},
+			false,
+		},
+		"Pattern without an owner": {
+			input{"eujoy/gitpr", "gitpr*"},
+			false,
+		},
+		"Pattern for any owner": {
+			input{"other/gitpr", "*/gitpr"},
+			true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actualMatch := utilities.MatchesRepositoryPattern(tc.input.repository, tc.input.pattern)
+
+			if tc.expected != actualMatch {
+				t.Errorf("Expected to get '%v' as match, but got '%v'", tc.expected, actualMatch)
+			}
+		})
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var cfg config.Config
+	cfg.Settings.MaxConcurrentRequests = 2
+
+	utilities := utils.New(cfg)
+
+	t.Run("Call the function for every index", func(t *testing.T) {
+		var mutex sync.Mutex
+		running, maxRunning := 0, 0
+		results := make([]int, 5)
+
+		err := utilities.RunConcurrently(len(results), func(idx int) error {
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mutex.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+			results[idx] = idx * 2
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+
+			return nil
+		})
+
+		expectedResults := []int{0, 2, 4, 6, 8}
+		if !reflect.DeepEqual(expectedResults, results) {
+			t.Errorf("Expected to get '%v' as results, but got '%v'", expectedResults, results)
+		}
+		if maxRunning > cfg.Settings.MaxConcurrentRequests {
+			t.Errorf("Expected to run at most '%v' calls at the same time, but got '%v'", cfg.Settings.MaxConcurrentRequests, maxRunning)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Fail to run one of the calls - expecting an error", func(t *testing.T) {
+		expectedError := errors.New("failed")
+
+		actualError := utilities.RunConcurrently(3, func(idx int) error {
+			if idx == 1 {
+				return expectedError
+			}
+
+			return nil
+		})
+
+		if !reflect.DeepEqual(expectedError, actualError) {
+			t.Errorf("Expected to get '%v' as error, but got '%v'", expectedError, actualError)
+		}
+	})
+
+	t.Run("Fail to run more than one of the calls - expecting the error of the lowest index", func(t *testing.T) {
+		expectedError := errors.New("first")
+
+		actualError := utilities.RunConcurrently(2, func(idx int) error {
+			if idx == 0 {
+				time.Sleep(10 * time.Millisecond)
+				return expectedError
+			}
+
+			return errors.New("second")
+		})
+
+		if !reflect.DeepEqual(expectedError, actualError) {
+			t.Errorf("Expected to get '%v' as error, but got '%v'", expectedError, actualError)
+		}
+	})
+
+	t.Run("Fail to run a call - expecting no new calls to start", func(t *testing.T) {
+		var mutex sync.Mutex
+		calls := 0
+
+		_ = utils.New(config.Config{}).RunConcurrently(5, func(idx int) error {
+			mutex.Lock()
+			calls++
+			mutex.Unlock()
+
+			return errors.New("failed")
+		})
+
+		if calls != 1 {
+			t.Errorf("Expected to get '%v' as calls, but got '%v'", 1, calls)
+		}
+	})
+}
+
+func TestGetAssetFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "assets")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory : %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"app-linux.tar.gz", "app-darwin.tar.gz", "notes.txt"} {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644)
+		if err != nil {
+			t.Fatalf("Failed to write asset file : %v", err)
+		}
+	}
+
+	err = os.Mkdir(filepath.Join(dir, "dir.tar.gz"), 0755)
+	if err != nil {
+		t.Fatalf("Failed to create directory : %v", err)
+	}
+
+	utilities := utils.New(config.Config{})
+
+	t.Run("Files of the patterns without duplicates and directories", func(t *testing.T) {
+		actual, err := utilities.GetAssetFiles([]string{filepath.Join(dir, "*.tar.gz"), filepath.Join(dir, "app-linux.tar.gz")})
+
+		expected := []string{filepath.Join(dir, "app-darwin.tar.gz"), filepath.Join(dir, "app-linux.tar.gz")}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected to get '%v' as files, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+
+	t.Run("Pattern without any file - expecting an error", func(t *testing.T) {
+		_, err := utilities.GetAssetFiles([]string{filepath.Join(dir, "*.zip")})
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+
+	t.Run("Files with the same name - expecting an error", func(t *testing.T) {
+		err := os.Mkdir(filepath.Join(dir, "other"), 0755)
+		if err != nil {
+			t.Fatalf("Failed to create directory : %v", err)
+		}
+
+		err = ioutil.WriteFile(filepath.Join(dir, "other", "notes.txt"), []byte("other"), 0644)
+		if err != nil {
+			t.Fatalf("Failed to write asset file : %v", err)
+		}
+
+		_, err = utilities.GetAssetFiles([]string{filepath.Join(dir, "notes.txt"), filepath.Join(dir, "other", "*.txt")})
+		if err == nil {
+			t.Errorf("Expected to get an error, but got nil")
+		}
+	})
+
+	t.Run("Checksums of the files", func(t *testing.T) {
+		actual, err := utilities.BuildChecksums([]string{filepath.Join(dir, "notes.txt")})
+
+		expected := "e39538e7f27a7bf579cd9b85a103c0f0b86b60b788534295538d0301a9c5dce6  notes.txt\n"
+		if actual != expected {
+			t.Errorf("Expected to get '%v' as checksums, but got '%v'", expected, actual)
+		}
+		if err != nil {
+			t.Errorf("Expected to get nil as error, but got '%v'", err)
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package mock
 
 import (
+	"io"
+	"time"
+
 	"github.com/eujoy/gitpr/internal/domain"
 	"github.com/stretchr/testify/mock"
 )
@@ -30,3 +33,206 @@ func (c *Client) GetReviewStateOfPullRequest(authToken, repoOwner, repository st
 
 	return args.Get(0).([]domain.PullRequestReview), args.Error(1)
 }
+
+// GetTeamMembers mock implementation.
+func (c *Client) GetTeamMembers(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.User, error) {
+	args := c.MethodCalled("GetTeamMembers", authToken, org, teamSlug, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+// GetPullRequestsCommits mock implementation.
+func (c *Client) GetPullRequestsCommits(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.Commit, error) {
+	args := c.MethodCalled("GetPullRequestsCommits", authToken, repoOwner, repository, pullRequestNumber, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Commit), args.Error(1)
+}
+
+// GetPullRequestsDetails mock implementation.
+func (c *Client) GetPullRequestsDetails(authToken, repoOwner, repository string, pullRequestNumber int) (domain.PullRequest, error) {
+	args := c.MethodCalled("GetPullRequestsDetails", authToken, repoOwner, repository, pullRequestNumber)
+
+	return args.Get(0).(domain.PullRequest), args.Error(1)
+}
+
+// GetIssueComments mock implementation.
+func (c *Client) GetIssueComments(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.Comment, error) {
+	args := c.MethodCalled("GetIssueComments", authToken, repoOwner, repository, issueNumber, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Comment), args.Error(1)
+}
+
+// GetIssueTimeline mock implementation.
+func (c *Client) GetIssueTimeline(authToken, repoOwner, repository string, issueNumber, pageSize, pageNumber int) ([]domain.TimelineEvent, error) {
+	args := c.MethodCalled("GetIssueTimeline", authToken, repoOwner, repository, issueNumber, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.TimelineEvent), args.Error(1)
+}
+
+// GetPullRequestFiles mock implementation.
+func (c *Client) GetPullRequestFiles(authToken, repoOwner, repository string, pullRequestNumber, pageSize, pageNumber int) ([]domain.CommitFile, error) {
+	args := c.MethodCalled("GetPullRequestFiles", authToken, repoOwner, repository, pullRequestNumber, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.CommitFile), args.Error(1)
+}
+
+// RequestReviewers mock implementation.
+func (c *Client) RequestReviewers(authToken, repoOwner, repository string, pullRequestNumber int, reviewers []string) error {
+	args := c.MethodCalled("RequestReviewers", authToken, repoOwner, repository, pullRequestNumber, reviewers)
+
+	return args.Error(0)
+}
+
+// GetRepositoryContent mock implementation.
+func (c *Client) GetRepositoryContent(authToken, repoOwner, repository, path, ref string) (domain.RepositoryContent, error) {
+	args := c.MethodCalled("GetRepositoryContent", authToken, repoOwner, repository, path, ref)
+
+	return args.Get(0).(domain.RepositoryContent), args.Error(1)
+}
+
+// GetRepositoryTree mock implementation.
+func (c *Client) GetRepositoryTree(authToken, repoOwner, repository, ref string) (domain.RepositoryTree, error) {
+	args := c.MethodCalled("GetRepositoryTree", authToken, repoOwner, repository, ref)
+
+	return args.Get(0).(domain.RepositoryTree), args.Error(1)
+}
+
+// GetOrganizationRepos mock implementation.
+func (c *Client) GetOrganizationRepos(authToken, org string, pageSize, pageNumber int) ([]domain.Repository, error) {
+	args := c.MethodCalled("GetOrganizationRepos", authToken, org, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Repository), args.Error(1)
+}
+
+// GetTeamRepos mock implementation.
+func (c *Client) GetTeamRepos(authToken, org, teamSlug string, pageSize, pageNumber int) ([]domain.Repository, error) {
+	args := c.MethodCalled("GetTeamRepos", authToken, org, teamSlug, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Repository), args.Error(1)
+}
+
+// GetAuthenticatedUser mock implementation.
+func (c *Client) GetAuthenticatedUser(authToken string) (domain.User, error) {
+	args := c.MethodCalled("GetAuthenticatedUser", authToken)
+
+	return args.Get(0).(domain.User), args.Error(1)
+}
+
+// GetCommitPullRequests mock implementation.
+func (c *Client) GetCommitPullRequests(authToken, repoOwner, repository, commitSha string) ([]domain.PullRequest, error) {
+	args := c.MethodCalled("GetCommitPullRequests", authToken, repoOwner, repository, commitSha)
+
+	return args.Get(0).([]domain.PullRequest), args.Error(1)
+}
+
+// SearchIssues mock implementation.
+func (c *Client) SearchIssues(authToken, query string, pageSize, pageNumber int) (domain.SearchIssuesResponse, error) {
+	args := c.MethodCalled("SearchIssues", authToken, query, pageSize, pageNumber)
+
+	return args.Get(0).(domain.SearchIssuesResponse), args.Error(1)
+}
+
+// CreateRelease mock implementation.
+func (c *Client) CreateRelease(authToken, repoOwner, repository, tagName string, draftRelease bool, name, body string) error {
+	args := c.MethodCalled("CreateRelease", authToken, repoOwner, repository, tagName, draftRelease, name, body)
+
+	return args.Error(0)
+}
+
+// GetCommitDetails mock implementation.
+func (c *Client) GetCommitDetails(authToken, repoOwner, repository, commitSha string) (domain.Commit, error) {
+	args := c.MethodCalled("GetCommitDetails", authToken, repoOwner, repository, commitSha)
+
+	return args.Get(0).(domain.Commit), args.Error(1)
+}
+
+// GetCommitList mock implementation.
+func (c *Client) GetCommitList(authToken, repoOwner, repository, branch string, since, until time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	args := c.MethodCalled("GetCommitList", authToken, repoOwner, repository, branch, since, until, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Commit), args.Error(1)
+}
+
+// GetFileCommitList mock implementation.
+func (c *Client) GetFileCommitList(authToken, repoOwner, repository, branch, path string, since time.Time, pageSize, pageNumber int) ([]domain.Commit, error) {
+	args := c.MethodCalled("GetFileCommitList", authToken, repoOwner, repository, branch, path, since, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Commit), args.Error(1)
+}
+
+// GetDiffBetweenTags mock implementation.
+func (c *Client) GetDiffBetweenTags(authToken, repoOwner, repository, existingTag, latestTag string) (domain.CompareTagsResponse, error) {
+	args := c.MethodCalled("GetDiffBetweenTags", authToken, repoOwner, repository, existingTag, latestTag)
+
+	return args.Get(0).(domain.CompareTagsResponse), args.Error(1)
+}
+
+// GetReleaseList mock implementation.
+func (c *Client) GetReleaseList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Release, error) {
+	args := c.MethodCalled("GetReleaseList", authToken, repoOwner, repository, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Release), args.Error(1)
+}
+
+// GetTagList mock implementation.
+func (c *Client) GetTagList(authToken, repoOwner, repository string, pageSize, pageNumber int) ([]domain.Tag, error) {
+	args := c.MethodCalled("GetTagList", authToken, repoOwner, repository, pageSize, pageNumber)
+
+	return args.Get(0).([]domain.Tag), args.Error(1)
+}
+
+// GetBranchReference mock implementation.
+func (c *Client) GetBranchReference(authToken, repoOwner, repository, branch string) (domain.GitReference, error) {
+	args := c.MethodCalled("GetBranchReference", authToken, repoOwner, repository, branch)
+
+	return args.Get(0).(domain.GitReference), args.Error(1)
+}
+
+// CreateReference mock implementation.
+func (c *Client) CreateReference(authToken, repoOwner, repository, branch, sha string) (domain.GitReference, error) {
+	args := c.MethodCalled("CreateReference", authToken, repoOwner, repository, branch, sha)
+
+	return args.Get(0).(domain.GitReference), args.Error(1)
+}
+
+// UpdateRepositoryContent mock implementation.
+func (c *Client) UpdateRepositoryContent(authToken, repoOwner, repository, path, branch, message, content, sha string) (domain.RepositoryContentUpdate, error) {
+	args := c.MethodCalled("UpdateRepositoryContent", authToken, repoOwner, repository, path, branch, message, content, sha)
+
+	return args.Get(0).(domain.RepositoryContentUpdate), args.Error(1)
+}
+
+// CreatePullRequest mock implementation.
+func (c *Client) CreatePullRequest(authToken, repoOwner, repository, title, head, base, body string) (domain.PullRequest, error) {
+	args := c.MethodCalled("CreatePullRequest", authToken, repoOwner, repository, title, head, base, body)
+
+	return args.Get(0).(domain.PullRequest), args.Error(1)
+}
+
+// UpdateRelease mock implementation.
+func (c *Client) UpdateRelease(authToken, repoOwner, repository string, releaseID int, releaseUpdate domain.ReleaseUpdate) (domain.Release, error) {
+	args := c.MethodCalled("UpdateRelease", authToken, repoOwner, repository, releaseID, releaseUpdate)
+
+	return args.Get(0).(domain.Release), args.Error(1)
+}
+
+// DeleteRelease mock implementation.
+func (c *Client) DeleteRelease(authToken, repoOwner, repository string, releaseID int) error {
+	args := c.MethodCalled("DeleteRelease", authToken, repoOwner, repository, releaseID)
+
+	return args.Error(0)
+}
+
+// UploadReleaseAsset mock implementation.
+func (c *Client) UploadReleaseAsset(authToken, repoOwner, repository string, releaseID int, name, contentType string, content io.Reader, size int64) (domain.ReleaseAsset, error) {
+	args := c.MethodCalled("UploadReleaseAsset", authToken, repoOwner, repository, releaseID, name, contentType, content, size)
+
+	return args.Get(0).(domain.ReleaseAsset), args.Error(1)
+}
+
+// DeleteReleaseAsset mock implementation.
+func (c *Client) DeleteReleaseAsset(authToken, repoOwner, repository string, assetID int) error {
+	args := c.MethodCalled("DeleteReleaseAsset", authToken, repoOwner, repository, assetID)
+
+	return args.Error(0)
+}